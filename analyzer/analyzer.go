@@ -0,0 +1,128 @@
+// Package analyzer implements token filters applied to words of text fields
+// both at ingestion and at query time, so the same words are indexed and searched.
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	StemmerEnglish = "english"
+	StemmerRussian = "russian"
+)
+
+// Config describes analyzer. Filters are applied in the following order:
+// lowercase, ASCII folding, min token length, stop words, stemming.
+type Config struct {
+	// Lowercase converts tokens to lower case regardless of indexing.case_sensitive option.
+	Lowercase bool
+	// ASCIIFolding converts letters with diacritics to ASCII letters, e.g. "café" to "cafe".
+	ASCIIFolding bool
+	// Stopwords are not indexed. Predefined lists can be used with names "_english_" and "_russian_".
+	Stopwords []string
+	// Stemmer is the language of snowball stemmer: "english" or "russian". Stemming implies lowercase.
+	Stemmer string
+	// MinTokenLength is the minimal length of token in characters, shorter tokens are not indexed.
+	MinTokenLength int
+}
+
+// Analyzer applies token filters to the tokens of text field.
+type Analyzer struct {
+	name      string
+	lowercase bool
+	folding   bool
+	minLength int
+	stopwords map[string]struct{}
+	stem      func([]byte) []byte
+}
+
+func New(name string, cfg Config) (*Analyzer, error) {
+	a := &Analyzer{
+		name:      name,
+		lowercase: cfg.Lowercase,
+		folding:   cfg.ASCIIFolding,
+		minLength: cfg.MinTokenLength,
+	}
+
+	if cfg.MinTokenLength < 0 {
+		return nil, fmt.Errorf("negative min token length: %d", cfg.MinTokenLength)
+	}
+
+	switch cfg.Stemmer {
+	case "":
+	case StemmerEnglish:
+		a.stem = stemEnglish
+		a.lowercase = true
+	case StemmerRussian:
+		a.stem = stemRussian
+		a.lowercase = true
+	default:
+		return nil, fmt.Errorf("unknown stemmer %q", cfg.Stemmer)
+	}
+
+	if len(cfg.Stopwords) > 0 {
+		a.stopwords = make(map[string]struct{})
+	}
+	for _, w := range cfg.Stopwords {
+		words := []string{w}
+		if predefined, ok := predefinedStopwords[w]; ok {
+			words = predefined
+		}
+		for _, w := range words {
+			// Stop words are compared with normalized tokens.
+			a.stopwords[string(a.Normalize([]byte(w)))] = struct{}{}
+		}
+	}
+
+	return a, nil
+}
+
+func (a *Analyzer) Name() string {
+	return a.name
+}
+
+// Lowercase returns true if tokens are converted to lower case.
+func (a *Analyzer) Lowercase() bool {
+	return a.lowercase
+}
+
+// Normalize applies lowercase and ASCII folding filters.
+// It is used for tokens with wildcards, which can't be stemmed.
+func (a *Analyzer) Normalize(token []byte) []byte {
+	if a.lowercase {
+		token = bytes.ToLower(token)
+	}
+	if a.folding {
+		token = foldASCII(token)
+	}
+	return token
+}
+
+// Analyze applies all the filters to the token.
+// It returns false if the token must not be indexed or searched.
+func (a *Analyzer) Analyze(token []byte) ([]byte, bool) {
+	token = a.Normalize(token)
+	if a.minLength > 0 && utf8.RuneCount(token) < a.minLength {
+		return nil, false
+	}
+	if _, ok := a.stopwords[string(token)]; ok {
+		return nil, false
+	}
+	if a.stem != nil {
+		token = a.stem(token)
+	}
+	return token, true
+}
+
+// AnalyzeString is like Analyze, but for strings.
+func (a *Analyzer) AnalyzeString(token string) (string, bool) {
+	res, ok := a.Analyze([]byte(token))
+	return string(res), ok
+}
+
+// NormalizeString is like Normalize, but for strings.
+func (a *Analyzer) NormalizeString(token string) string {
+	return string(a.Normalize([]byte(token)))
+}
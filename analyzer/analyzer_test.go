@@ -0,0 +1,63 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	a, err := New("test", Config{
+		ASCIIFolding:   true,
+		Stopwords:      []string{"_russian_", "Пожалуйста"},
+		Stemmer:        StemmerRussian,
+		MinTokenLength: 2,
+	})
+	require.NoError(t, err)
+	assert.True(t, a.Lowercase())
+
+	tests := []struct {
+		token    string
+		expected string
+		ok       bool
+	}{
+		{token: "Ошибки", expected: "ошибк", ok: true},
+		{token: "не", ok: false},
+		{token: "пожалуйста", ok: false},
+		{token: "x", ok: false},
+		{token: "Café", expected: "cafe", ok: true},
+		{token: "мой", ok: false},
+		{token: "Мойка", expected: "мойк", ok: true},
+	}
+	for _, tc := range tests {
+		res, ok := a.AnalyzeString(tc.token)
+		assert.Equal(t, tc.ok, ok, tc.token)
+		assert.Equal(t, tc.expected, res, tc.token)
+	}
+
+	assert.Equal(t, "ошибк*", a.NormalizeString("ОШИБК*"))
+}
+
+func TestFoldASCII(t *testing.T) {
+	tests := map[string]string{
+		"plain":      "plain",
+		"crème":      "creme",
+		"Ærøskøbing": "AEroskobing",
+		"straße":     "strasse",
+		"Łódź":       "Lodz",
+		"ёжик":       "ёжик",
+		"йод":        "йод",
+	}
+	for token, expected := range tests {
+		assert.Equal(t, expected, string(foldASCII([]byte(token))), token)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	_, err := New("test", Config{Stemmer: "french"})
+	assert.Error(t, err)
+
+	_, err = New("test", Config{MinTokenLength: -1})
+	assert.Error(t, err)
+}
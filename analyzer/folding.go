@@ -0,0 +1,80 @@
+package analyzer
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// foldings maps latin letters with diacritics to their ASCII equivalents.
+var foldings = buildFoldings()
+
+func buildFoldings() map[rune]string {
+	m := map[rune]string{
+		'ß': "ss", 'ẞ': "SS",
+		'æ': "ae", 'Æ': "AE",
+		'œ': "oe", 'Œ': "OE",
+		'ø': "o", 'Ø': "O",
+		'đ': "d", 'Đ': "D",
+		'ð': "d", 'Ð': "D",
+		'ł': "l", 'Ł': "L",
+		'ħ': "h", 'Ħ': "H",
+		'þ': "th", 'Þ': "TH",
+		'ı': "i",
+	}
+
+	// Letters which canonical decomposition is an ASCII letter followed by combining marks,
+	// e.g. 'é' is 'e' and U+0301. Only latin ranges are folded, so 'й' and 'ё' are kept as is.
+	ranges := [][2]rune{{0x00C0, 0x024F}, {0x1E00, 0x1EFF}}
+	for _, r := range ranges {
+		for c := r[0]; c <= r[1]; c++ {
+			if _, ok := m[c]; ok {
+				continue
+			}
+			d := []rune(norm.NFD.String(string(c)))
+			if len(d) < 2 || d[0] >= utf8.RuneSelf || !unicode.IsLetter(d[0]) {
+				continue
+			}
+			if !allMarks(d[1:]) {
+				continue
+			}
+			m[c] = string(d[0])
+		}
+	}
+	return m
+}
+
+func allMarks(runes []rune) bool {
+	for _, r := range runes {
+		if !unicode.Is(unicode.Mn, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// foldASCII replaces latin letters with diacritics with ASCII letters.
+func foldASCII(token []byte) []byte {
+	i := 0
+	for i < len(token) && token[i] < utf8.RuneSelf {
+		i++
+	}
+	if i == len(token) {
+		// Fast path: ASCII only.
+		return token
+	}
+
+	res := make([]byte, i, len(token))
+	copy(res, token[:i])
+	for i < len(token) {
+		r, size := utf8.DecodeRune(token[i:])
+		if f, ok := foldings[r]; ok {
+			res = append(res, f...)
+		} else {
+			res = append(res, token[i:i+size]...)
+		}
+		i += size
+	}
+	return res
+}
@@ -0,0 +1,323 @@
+package analyzer
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// stemEnglish implements english (porter2) snowball stemmer.
+// See https://snowballstem.org/algorithms/english/stemmer.html.
+// The token is expected to be in lower case. Tokens with non-ASCII letters are returned as is.
+func stemEnglish(token []byte) []byte {
+	if len(token) <= 2 || !isASCII(token) {
+		return token
+	}
+	if res, ok := englishExceptions1[string(token)]; ok {
+		return []byte(res)
+	}
+
+	s := englishStemmer{w: bytes.Clone(token)}
+	s.prelude()
+	s.markRegions()
+
+	s.step1a()
+	if _, ok := englishExceptions2[string(s.w)]; !ok {
+		s.step1b()
+		s.step1c()
+		s.step2()
+		s.step3()
+		s.step4()
+		s.step5()
+	}
+
+	for i, c := range s.w {
+		if c == 'Y' {
+			s.w[i] = 'y'
+		}
+	}
+	return s.w
+}
+
+var englishExceptions1 = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli", "singly": "singl",
+	"sky": "sky", "news": "news", "howe": "howe",
+	"atlas": "atlas", "cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+var englishExceptions2 = map[string]struct{}{
+	"inning": {}, "outing": {}, "canning": {}, "herring": {}, "earring": {},
+	"proceed": {}, "exceed": {}, "succeed": {},
+}
+
+type englishStemmer struct {
+	w      []byte
+	p1, p2 int
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func isEnglishVowel(c byte) bool {
+	switch c {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func (s *englishStemmer) vowel(i int) bool {
+	return isEnglishVowel(s.w[i])
+}
+
+// prelude marks consonant 'y' as 'Y'.
+func (s *englishStemmer) prelude() {
+	if s.w[0] == 'y' {
+		s.w[0] = 'Y'
+	}
+	for i := 1; i < len(s.w); i++ {
+		if s.w[i] == 'y' && s.vowel(i-1) {
+			s.w[i] = 'Y'
+		}
+	}
+}
+
+func (s *englishStemmer) markRegions() {
+	s.p1 = len(s.w)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if bytes.HasPrefix(s.w, []byte(prefix)) {
+			s.p1 = len(prefix)
+			break
+		}
+	}
+	if s.p1 == len(s.w) {
+		s.p1 = s.nextRegion(0)
+	}
+	s.p2 = s.nextRegion(s.p1)
+}
+
+// nextRegion returns the position after the first non-vowel following a vowel starting from pos.
+func (s *englishStemmer) nextRegion(pos int) int {
+	for i := pos + 1; i < len(s.w); i++ {
+		if !s.vowel(i) && s.vowel(i-1) {
+			return i + 1
+		}
+	}
+	return len(s.w)
+}
+
+func (s *englishStemmer) hasSuffix(suffix string) bool {
+	return bytes.HasSuffix(s.w, []byte(suffix))
+}
+
+// longestSuffix returns the longest of the suffixes the word ends with.
+func (s *englishStemmer) longestSuffix(suffixes ...string) string {
+	res := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(res) && s.hasSuffix(suffix) {
+			res = suffix
+		}
+	}
+	return res
+}
+
+func (s *englishStemmer) replace(suffix, repl string) {
+	s.w = append(s.w[:len(s.w)-len(suffix)], repl...)
+}
+
+func (s *englishStemmer) inR1(suffix string) bool {
+	return len(s.w)-len(suffix) >= s.p1
+}
+
+func (s *englishStemmer) inR2(suffix string) bool {
+	return len(s.w)-len(suffix) >= s.p2
+}
+
+// shortSyllable reports whether the word part before pos ends with a short syllable.
+func (s *englishStemmer) shortSyllable(pos int) bool {
+	if pos == 2 {
+		// A vowel at the beginning of the word followed by a non-vowel.
+		return s.vowel(0) && !s.vowel(1)
+	}
+	if pos < 2 {
+		return false
+	}
+	last := s.w[pos-1]
+	return !s.vowel(pos-1) && last != 'w' && last != 'x' && last != 'Y' && s.vowel(pos-2) && !s.vowel(pos-3)
+}
+
+func (s *englishStemmer) isShort() bool {
+	return s.p1 >= len(s.w) && s.shortSyllable(len(s.w))
+}
+
+func (s *englishStemmer) containsVowel(end int) bool {
+	for i := 0; i < end; i++ {
+		if s.vowel(i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *englishStemmer) step1a() {
+	switch suffix := s.longestSuffix("sses", "ied", "ies", "s", "us", "ss"); suffix {
+	case "sses":
+		s.replace(suffix, "ss")
+	case "ied", "ies":
+		if len(s.w) > 4 {
+			s.replace(suffix, "i")
+		} else {
+			s.replace(suffix, "ie")
+		}
+	case "s":
+		// Delete if the preceding word part contains a vowel not immediately before the s.
+		if s.containsVowel(len(s.w) - 2) {
+			s.replace(suffix, "")
+		}
+	}
+}
+
+func (s *englishStemmer) step1b() {
+	switch suffix := s.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly"); suffix {
+	case "eed", "eedly":
+		if s.inR1(suffix) {
+			s.replace(suffix, "ee")
+		}
+	case "ed", "edly", "ing", "ingly":
+		if !s.containsVowel(len(s.w) - len(suffix)) {
+			return
+		}
+		s.replace(suffix, "")
+		switch {
+		case s.hasSuffix("at") || s.hasSuffix("bl") || s.hasSuffix("iz"):
+			s.w = append(s.w, 'e')
+		case s.endsWithDouble():
+			s.w = s.w[:len(s.w)-1]
+		case s.isShort():
+			s.w = append(s.w, 'e')
+		}
+	}
+}
+
+func (s *englishStemmer) endsWithDouble() bool {
+	for _, d := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if s.hasSuffix(d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *englishStemmer) step1c() {
+	n := len(s.w)
+	if n > 2 && (s.w[n-1] == 'y' || s.w[n-1] == 'Y') && !s.vowel(n-2) {
+		s.w[n-1] = 'i'
+	}
+}
+
+var englishStep2 = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+	"izer": "ize", "ization": "ize",
+	"ational": "ate", "ation": "ate", "ator": "ate",
+	"alism": "al", "aliti": "al", "alli": "al",
+	"fulness": "ful", "ousli": "ous", "ousness": "ous",
+	"iveness": "ive", "iviti": "ive",
+	"biliti": "ble", "bli": "ble",
+	"ogi": "og", "fulli": "ful", "lessli": "less", "li": "",
+}
+
+var englishStep2Suffixes = mapKeys(englishStep2)
+
+func (s *englishStemmer) step2() {
+	suffix := s.longestSuffix(englishStep2Suffixes...)
+	if suffix == "" || !s.inR1(suffix) {
+		return
+	}
+	switch suffix {
+	case "ogi":
+		if len(s.w) < 4 || s.w[len(s.w)-4] != 'l' {
+			return
+		}
+	case "li":
+		if len(s.w) < 3 || !isValidLi(s.w[len(s.w)-3]) {
+			return
+		}
+	}
+	s.replace(suffix, englishStep2[suffix])
+}
+
+func isValidLi(c byte) bool {
+	switch c {
+	case 'c', 'd', 'e', 'g', 'h', 'k', 'm', 'n', 'r', 't':
+		return true
+	}
+	return false
+}
+
+var englishStep3 = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al",
+	"icate": "ic", "iciti": "ic", "ical": "ic",
+	"ful": "", "ness": "", "ative": "",
+}
+
+var englishStep3Suffixes = mapKeys(englishStep3)
+
+func (s *englishStemmer) step3() {
+	suffix := s.longestSuffix(englishStep3Suffixes...)
+	if suffix == "" || !s.inR1(suffix) {
+		return
+	}
+	if suffix == "ative" && !s.inR2(suffix) {
+		return
+	}
+	s.replace(suffix, englishStep3[suffix])
+}
+
+var englishStep4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement",
+	"ment", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+}
+
+func (s *englishStemmer) step4() {
+	suffix := s.longestSuffix(englishStep4Suffixes...)
+	if suffix == "" || !s.inR2(suffix) {
+		return
+	}
+	if suffix == "ion" {
+		if len(s.w) < 4 {
+			return
+		}
+		if c := s.w[len(s.w)-4]; c != 's' && c != 't' {
+			return
+		}
+	}
+	s.replace(suffix, "")
+}
+
+func (s *englishStemmer) step5() {
+	n := len(s.w)
+	switch s.w[n-1] {
+	case 'e':
+		if s.inR2("e") || s.inR1("e") && !s.shortSyllable(n-1) {
+			s.w = s.w[:n-1]
+		}
+	case 'l':
+		if s.inR2("l") && n > 1 && s.w[n-2] == 'l' {
+			s.w = s.w[:n-1]
+		}
+	}
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
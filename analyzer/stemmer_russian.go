@@ -0,0 +1,171 @@
+package analyzer
+
+import (
+	"strings"
+)
+
+// stemRussian implements russian snowball stemmer.
+// See https://snowballstem.org/algorithms/russian/stemmer.html.
+// The token is expected to be in lower case.
+func stemRussian(token []byte) []byte {
+	str := string(token)
+	if !strings.ContainsAny(str, russianVowels) {
+		return token
+	}
+
+	s := russianStemmer{w: []rune(strings.ReplaceAll(str, "ё", "е"))}
+	s.markRegions()
+
+	if !s.removeEnding(russianPerfectiveGerund1, russianPerfectiveGerund2) {
+		s.removeEnding(nil, russianReflexive)
+		if !s.removeAdjectival() && !s.removeEnding(russianVerb1, russianVerb2) {
+			s.removeEnding(nil, russianNoun)
+		}
+	}
+
+	// Step 2.
+	s.removeEnding(nil, []string{"и"})
+
+	// Step 3.
+	if suffix := s.longestSuffix(russianDerivational); suffix != "" && len(s.w)-runeLen(suffix) >= s.p2 {
+		s.w = s.w[:len(s.w)-runeLen(suffix)]
+	}
+
+	// Step 4.
+	switch s.longestSuffix([]string{"ейш", "ейше", "н", "ь"}) {
+	case "ейш", "ейше":
+		s.removeEnding(nil, russianSuperlative)
+		s.undoubleN()
+	case "н":
+		s.undoubleN()
+	case "ь":
+		s.w = s.w[:len(s.w)-1]
+	}
+
+	return []byte(string(s.w))
+}
+
+const russianVowels = "аеиоуыэюя"
+
+var (
+	russianPerfectiveGerund1 = []string{"в", "вши", "вшись"}
+	russianPerfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+
+	russianAdjective = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	russianParticiple1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	russianParticiple2 = []string{"ивш", "ывш", "ующ"}
+
+	russianReflexive = []string{"ся", "сь"}
+
+	russianVerb1 = []string{
+		"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно",
+	}
+	russianVerb2 = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+
+	russianNoun = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий",
+		"й", "иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью",
+		"ю", "ия", "ья", "я",
+	}
+
+	russianDerivational = []string{"ост", "ость"}
+	russianSuperlative  = []string{"ейш", "ейше"}
+)
+
+type russianStemmer struct {
+	w []rune
+	// rv is the start of the region after the first vowel, all endings are searched in it.
+	rv, p2 int
+}
+
+func isRussianVowel(r rune) bool {
+	return strings.ContainsRune(russianVowels, r)
+}
+
+func (s *russianStemmer) markRegions() {
+	s.rv = len(s.w)
+	for i, r := range s.w {
+		if isRussianVowel(r) {
+			s.rv = i + 1
+			break
+		}
+	}
+	p1 := s.nextRegion(s.rv)
+	s.p2 = s.nextRegion(p1)
+}
+
+// nextRegion returns the position after the first non-vowel following a vowel starting from pos.
+func (s *russianStemmer) nextRegion(pos int) int {
+	for i := pos + 1; i < len(s.w); i++ {
+		if !isRussianVowel(s.w[i]) && isRussianVowel(s.w[i-1]) {
+			return i + 1
+		}
+	}
+	return len(s.w)
+}
+
+func runeLen(s string) int {
+	return len([]rune(s))
+}
+
+// longestSuffix returns the longest of the suffixes the word ends with inside RV region.
+func (s *russianStemmer) longestSuffix(suffixes ...[]string) string {
+	res := ""
+	rv := string(s.w[s.rv:])
+	for _, group := range suffixes {
+		for _, suffix := range group {
+			if len(suffix) > len(res) && strings.HasSuffix(rv, suffix) {
+				res = suffix
+			}
+		}
+	}
+	return res
+}
+
+// removeEnding removes the longest ending of both groups.
+// Endings of the first group must be preceded by 'а' or 'я'.
+func (s *russianStemmer) removeEnding(group1, group2 []string) bool {
+	suffix := s.longestSuffix(group1, group2)
+	if suffix == "" {
+		return false
+	}
+	pos := len(s.w) - runeLen(suffix)
+	if contains(group1, suffix) && !contains(group2, suffix) {
+		if pos-1 < s.rv || s.w[pos-1] != 'а' && s.w[pos-1] != 'я' {
+			return false
+		}
+	}
+	s.w = s.w[:pos]
+	return true
+}
+
+func contains(group []string, suffix string) bool {
+	for _, s := range group {
+		if s == suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// removeAdjectival removes adjective ending optionally preceded by participle one.
+func (s *russianStemmer) removeAdjectival() bool {
+	if !s.removeEnding(nil, russianAdjective) {
+		return false
+	}
+	s.removeEnding(russianParticiple1, russianParticiple2)
+	return true
+}
+
+func (s *russianStemmer) undoubleN() {
+	n := len(s.w)
+	if n-2 >= s.rv && s.w[n-1] == 'н' && s.w[n-2] == 'н' {
+		s.w = s.w[:n-1]
+	}
+}
@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemEnglish(t *testing.T) {
+	tests := map[string]string{
+		"consign":       "consign",
+		"consigned":     "consign",
+		"consigning":    "consign",
+		"consignment":   "consign",
+		"consistency":   "consist",
+		"consistently":  "consist",
+		"consolation":   "consol",
+		"consolatory":   "consolatori",
+		"console":       "consol",
+		"consolidating": "consolid",
+		"consolingly":   "consol",
+		"conspicuously": "conspicu",
+		"conspiracy":    "conspiraci",
+		"conspirators":  "conspir",
+		"constable":     "constabl",
+		"constancy":     "constanc",
+		"generously":    "generous",
+		"connections":   "connect",
+		"failed":        "fail",
+		"failing":       "fail",
+		"running":       "run",
+		"hoped":         "hope",
+		"hopping":       "hop",
+		"cries":         "cri",
+		"ties":          "tie",
+		"gas":           "gas",
+		"kiwis":         "kiwi",
+		"happily":       "happili",
+		"skies":         "sky",
+		"exceed":        "exceed",
+		"is":            "is",
+		"café":          "café",
+	}
+	for word, expected := range tests {
+		assert.Equal(t, expected, string(stemEnglish([]byte(word))), word)
+	}
+}
+
+func TestStemRussian(t *testing.T) {
+	tests := map[string]string{
+		"вазы":         "ваз",
+		"вагоне":       "вагон",
+		"абсолютно":    "абсолютн",
+		"абсолютное":   "абсолютн",
+		"автомобиль":   "автомобил",
+		"автомобилями": "автомобил",
+		"ошибка":       "ошибк",
+		"ошибки":       "ошибк",
+		"ошибкой":      "ошибк",
+		"подключение":  "подключен",
+		"подключения":  "подключен",
+		"подключиться": "подключ",
+		"удалось":      "уда",
+		"важнейшие":    "важн",
+		"ёлка":         "елк",
+		"timeout":      "timeout",
+	}
+	for word, expected := range tests {
+		assert.Equal(t, expected, string(stemRussian([]byte(word))), word)
+	}
+}
@@ -191,6 +191,7 @@ func startProxy(
 			WriteStores:     writeStores,
 			ShuffleReplicas: cfg.Cluster.ShuffleReplicas,
 			MirrorAddr:      cfg.Cluster.MirrorAddress,
+			MaxScannedDocs:  cfg.Limits.ScannedDocs,
 		},
 		Bulk: bulk.IngestorConfig{
 			HotStores:   hotStores,
@@ -207,6 +208,7 @@ func startProxy(
 			MaxInflightBulks:       cfg.Limits.InflightBulks,
 			AllowedTimeDrift:       cfg.Indexing.PastAllowedTimeDrift,
 			FutureAllowedTimeDrift: cfg.Indexing.FutureAllowedTimeDrift,
+			DocTime:                docTimeConfig(cfg),
 			MappingProvider:        mp,
 			MaxTokenSize:           cfg.Indexing.MaxTokenSize,
 			CaseSensitive:          cfg.Indexing.CaseSensitive,
@@ -274,6 +276,8 @@ func startStore(
 						MaxGroupTokens:     cfg.Limits.Aggregation.GroupTokens,
 						MaxTIDsPerFraction: cfg.Limits.Aggregation.FractionTokens,
 					},
+					MaxRegexpTokens: cfg.Limits.RegexpTokens,
+					MaxVerifiedDocs: cfg.Limits.VerifiedDocs,
 				},
 				SkipSortDocs: !cfg.DocsSorting.Enabled,
 				KeepMetaFile: false,
@@ -355,3 +359,14 @@ func initS3Client(cfg config.Config) *s3.Client {
 func enableIndexingForAllFields(mappingPath string) bool {
 	return mappingPath == "auto"
 }
+
+func docTimeConfig(cfg config.Config) bulk.DocTimeConfig {
+	docTime := bulk.DocTimeConfig{
+		Formats:        cfg.Indexing.TimestampFormats,
+		RejectUnparsed: cfg.Indexing.RejectUnparsedTimestamp,
+	}
+	for _, field := range cfg.Indexing.TimestampFields {
+		docTime.Fields = append(docTime.Fields, strings.Split(field, "."))
+	}
+	return docTime
+}
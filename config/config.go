@@ -122,6 +122,17 @@ type Config struct {
 		// DocSize specifies maximum possible size for single document.
 		// Document larger than this threshold will be skipped.
 		DocSize Bytes `config:"doc_size" default:"128KiB"`
+		// RegexpTokens specifies maximum amount of field tokens that can be matched
+		// with regular expression filter within single fraction.
+		// Setting this field to 0 disables limit.
+		RegexpTokens int `config:"regexp_tokens" default:"100000"`
+		// VerifiedDocs specifies maximum amount of documents that can be read
+		// to verify substring and phrase filters within single fraction.
+		// Setting this field to 0 disables limit.
+		VerifiedDocs int `config:"verified_docs" default:"100000"`
+		// ScannedDocs specifies maximum amount of found documents that can be fetched
+		// to apply pipes like 'where' within single search request.
+		ScannedDocs int `config:"scanned_docs" default:"10000"`
 
 		Aggregation struct {
 			// FieldTokens specifies maximum amount of unique field tokens
@@ -193,6 +204,15 @@ type Config struct {
 		// FutureAllowedTimeDrift specifies the maximum allowable offset for a message’s timestamp into the future.
 		// If a message’s timestamp is further in the future than FutureAllowedTimeDrift, it is overwritten.
 		FutureAllowedTimeDrift time.Duration `config:"future_allowed_time_drift" default:"5m"`
+		// TimestampFields is a list of dot-separated paths to the document timestamp, e.g. "event.created".
+		// The first field with parsable value is used. Defaults to "timestamp", "time" and "ts".
+		TimestampFields []string `config:"timestamp_fields"`
+		// TimestampFormats is a list of Go time layouts or epoch formats: "unix", "unix_milli", "unix_micro" and "unix_nano".
+		// Defaults to "2006-01-02 15:04:05.999", RFC3339Nano and RFC3339.
+		TimestampFormats []string `config:"timestamp_formats"`
+		// RejectUnparsedTimestamp drops documents without parsable timestamp.
+		// Otherwise the time of the bulk request is used as the document timestamp.
+		RejectUnparsedTimestamp bool `config:"reject_unparsed_timestamp"`
 	} `config:"indexing"`
 
 	Mapping struct {
@@ -42,6 +42,7 @@ func (c *Config) proxyValidations() []validateFn {
 		greaterThan("limits.query_rate", 0, c.Limits.QueryRate),
 		greaterThan("limits.inflight_bulks", 0, c.Limits.InflightBulks),
 		greaterThan("limits.doc_size", 0, c.Limits.DocSize),
+		greaterThan("limits.scanned_docs", 0, c.Limits.ScannedDocs),
 	}
 }
 
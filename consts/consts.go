@@ -22,6 +22,10 @@ const (
 
 	DefaultMaxTokenSize = 72
 
+	// Default n-gram lengths of ngram fields.
+	DefaultMinGram = 3
+	DefaultMaxGram = 3
+
 	DefaultBulkRequestsLimit   = 32
 	DefaultSearchRequestsLimit = 32
 
@@ -31,6 +35,13 @@ const (
 
 	ESTimeFormat = "2006-01-02 15:04:05.999"
 
+	// Epoch time formats. Both JSON numbers and strings of digits are accepted,
+	// seconds, milliseconds and microseconds may have a fractional part.
+	TimeFormatUnix      = "unix"
+	TimeFormatUnixMilli = "unix_milli"
+	TimeFormatUnixMicro = "unix_micro"
+	TimeFormatUnixNano  = "unix_nano"
+
 	BulkTimeout          = 30 * time.Second
 	DefaultSearchTimeout = 30 * time.Second
 	DefaultExportTimeout = 2 * time.Minute
@@ -81,4 +92,6 @@ var (
 	ErrInvalidArgument           = errors.New("invalid argument")
 	ErrTooManyUniqValues         = errors.New("aggregation has too many unique values")
 	ErrTooManyFractionsHit       = errors.New("too many fractions hit")
+	ErrTooManyRegexpTokens       = errors.New("regular expression checks too many tokens")
+	ErrTooManyVerifiedDocs       = errors.New("too many documents to verify")
 )
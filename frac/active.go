@@ -308,6 +308,30 @@ func (f *Active) Info() *Info {
 	return &cp
 }
 
+// SetBinaryFields records index types of the fields with binary encoded tokens (see Info.BinaryFields).
+func (f *Active) SetBinaryFields(fields map[string]string) {
+	f.infoMu.Lock()
+	defer f.infoMu.Unlock()
+
+	f.info.BinaryFields = fields
+}
+
+// SetCaseSensitiveFields records case sensitivity of the fields of string types (see Info.CaseSensitiveFields).
+func (f *Active) SetCaseSensitiveFields(fields map[string]bool) {
+	f.infoMu.Lock()
+	defer f.infoMu.Unlock()
+
+	f.info.CaseSensitiveFields = fields
+}
+
+// SetTemplates records the mapping templates (see Info.Templates).
+func (f *Active) SetTemplates(templates []FieldTemplate) {
+	f.infoMu.Lock()
+	defer f.infoMu.Unlock()
+
+	f.info.Templates = templates
+}
+
 func (f *Active) Contains(id seq.MID) bool {
 	return f.Info().IsIntersecting(id, id)
 }
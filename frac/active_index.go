@@ -12,6 +12,7 @@ import (
 	"github.com/ozontech/seq-db/metric/stopwatch"
 	"github.com/ozontech/seq-db/node"
 	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/pattern"
 	"github.com/ozontech/seq-db/seq"
 	"github.com/ozontech/seq-db/storage"
 )
@@ -91,6 +92,15 @@ func (dp *activeDataProvider) getTokenIndex() *activeTokenIndex {
 		rids:      dp.rids,
 		tokenList: dp.tokenList,
 		inverser:  dp.getIDsIndex().inverser,
+		limits:    pattern.Limits{MaxRegexpTokens: dp.config.Search.MaxRegexpTokens},
+	}
+}
+
+func (dp *activeDataProvider) getFetchIndex() *activeFetchIndex {
+	return &activeFetchIndex{
+		blocksOffsets: dp.blocksOffsets,
+		docsPositions: dp.docsPositions,
+		docsReader:    dp.docsReader,
 	}
 }
 
@@ -100,14 +110,10 @@ func (dp *activeDataProvider) Fetch(ids []seq.ID) ([][]byte, error) {
 
 	res := make([][]byte, len(ids))
 
-	indexes := []activeFetchIndex{{
-		blocksOffsets: dp.blocksOffsets,
-		docsPositions: dp.docsPositions,
-		docsReader:    dp.docsReader,
-	}}
+	indexes := []*activeFetchIndex{dp.getFetchIndex()}
 
 	for _, fi := range indexes {
-		if err := processor.IndexFetch(ids, sw, &fi, res); err != nil {
+		if err := processor.IndexFetch(ids, sw, fi, res); err != nil {
 			return nil, err
 		}
 	}
@@ -127,6 +133,7 @@ func (dp *activeDataProvider) Search(params processor.SearchParams) (*seq.QPR, e
 	// we must limit the query range in accordance with the current fraction range [from; to].
 	params.From = max(params.From, dp.info.From)
 	params.To = min(params.To, dp.info.To)
+	params = adaptSearchParams(params, dp.info)
 
 	aggLimits := processor.AggLimits(dp.config.Search.AggLimits)
 
@@ -139,13 +146,14 @@ func (dp *activeDataProvider) Search(params processor.SearchParams) (*seq.QPR, e
 	indexes := []activeSearchIndex{{
 		activeIDsIndex:   dp.getIDsIndex(),
 		activeTokenIndex: dp.getTokenIndex(),
+		activeFetchIndex: dp.getFetchIndex(),
 	}}
 	m.Stop()
 
 	qprs := make([]*seq.QPR, 0, len(indexes))
 
 	for _, si := range indexes {
-		qpr, err := processor.IndexSearch(dp.ctx, params, &si, aggLimits, sw)
+		qpr, err := processor.IndexSearch(dp.ctx, params, &si, aggLimits, dp.config.Search.MaxVerifiedDocs, sw)
 		if err != nil {
 			return nil, err
 		}
@@ -200,6 +208,15 @@ func (p *activeIDsIndex) LessOrEqual(lid seq.LID, id seq.ID) bool {
 type activeSearchIndex struct {
 	*activeIDsIndex
 	*activeTokenIndex
+	*activeFetchIndex
+}
+
+func (si *activeSearchIndex) GetDocPosByLIDs(lids []seq.LID) []seq.DocPos {
+	ids := make([]seq.ID, len(lids))
+	for i, lid := range lids {
+		ids[i] = seq.ID{MID: si.GetMID(lid), RID: si.GetRID(lid)}
+	}
+	return si.GetDocPos(ids)
 }
 
 type activeTokenIndex struct {
@@ -208,6 +225,7 @@ type activeTokenIndex struct {
 	rids      *UInt64s
 	tokenList *TokenList
 	inverser  *inverser
+	limits    pattern.Limits
 }
 
 func (si *activeTokenIndex) GetValByTID(tid uint32) []byte {
@@ -215,7 +233,7 @@ func (si *activeTokenIndex) GetValByTID(tid uint32) []byte {
 }
 
 func (si *activeTokenIndex) GetTIDsByTokenExpr(t parser.Token) ([]uint32, error) {
-	return si.tokenList.FindPattern(si.ctx, t, nil)
+	return si.tokenList.FindPattern(si.ctx, t, si.limits, nil)
 }
 
 func (si *activeTokenIndex) GetLIDsFromTIDs(tids []uint32, _ lids.Counter, minLID, maxLID uint32, order seq.DocsOrder) []node.Node {
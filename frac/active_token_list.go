@@ -184,12 +184,12 @@ func (tl *TokenList) getTokenProvider(field string) *activeTokenProvider {
 	}
 }
 
-func (tl *TokenList) FindPattern(ctx context.Context, t parser.Token, tids []uint32) ([]uint32, error) {
+func (tl *TokenList) FindPattern(ctx context.Context, t parser.Token, limits pattern.Limits, tids []uint32) ([]uint32, error) {
 	field := parser.GetField(t)
 	tp := tl.getTokenProvider(field)
-	tids, err := pattern.Search(ctx, t, tp)
+	tids, err := pattern.Search(ctx, t, tp, limits)
 	if err != nil {
-		return nil, fmt.Errorf("search error: %s field: %s, query: %s", err, field, parser.GetHint(t))
+		return nil, fmt.Errorf("search error: %w field: %s, query: %s", err, field, parser.GetHint(t))
 	}
 	return tp.inverseTIDs(tids), nil
 }
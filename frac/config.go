@@ -9,6 +9,10 @@ type Config struct {
 
 type SearchConfig struct {
 	AggLimits AggLimits
+	// MaxRegexpTokens max number of field tokens checked by regular expression filter per fraction.
+	MaxRegexpTokens int
+	// MaxVerifiedDocs max number of documents read to verify substring and phrase filters per fraction.
+	MaxVerifiedDocs int
 }
 
 type AggLimits struct {
@@ -0,0 +1,209 @@
+package frac
+
+import (
+	"strings"
+
+	"github.com/ozontech/seq-db/frac/processor"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// FieldTemplate is a mapping template recorded in the fraction info (see seq.MappingTemplate).
+type FieldTemplate struct {
+	Match         string `json:"match"`
+	Type          string `json:"type"`
+	CaseSensitive bool   `json:"case_sensitive"`
+}
+
+// NewFieldTemplates returns templates of the mapping to be recorded in the fraction info.
+// Default case sensitivity is indexing.case_sensitive setting, see seq.MappingType.IsCaseSensitive.
+func NewFieldTemplates(mapping *seq.Mapping, caseSensitive bool) []FieldTemplate {
+	if mapping == nil || len(mapping.Templates) == 0 {
+		return nil
+	}
+	res := make([]FieldTemplate, 0, len(mapping.Templates))
+	for _, t := range mapping.Templates {
+		res = append(res, FieldTemplate{
+			Match:         t.Match,
+			Type:          seq.TokenTypesToNames[t.Types.Main.TokenizerType],
+			CaseSensitive: t.Types.Main.IsCaseSensitive(caseSensitive),
+		})
+	}
+	return res
+}
+
+// adaptSearchParams adjusts filters and aggregations to the index types the fraction was created with.
+// If the type of a field changed between text and binary one (e.g. from keyword to long),
+// old fractions still hold tokens of the old type, so they are searched according to it.
+// The same applies to case sensitivity of the fields.
+// Query tree is shared between fractions, so changed nodes are copied.
+func adaptSearchParams(params processor.SearchParams, info *Info) processor.SearchParams {
+	if info.BinaryFields == nil && info.CaseSensitiveFields == nil {
+		return params
+	}
+
+	params.AST = adaptAST(params.AST, info)
+
+	if len(params.AggQ) == 0 {
+		return params
+	}
+	aggs := make([]processor.AggQuery, len(params.AggQ))
+	for i, q := range params.AggQ {
+		if q.Field != nil {
+			q.FieldType = info.IndexType(q.Field.Field, q.FieldType)
+		}
+		if q.GroupBy != nil {
+			q.GroupByType = info.IndexType(q.GroupBy.Field, q.GroupByType)
+		}
+		if len(q.ThenBy) > 0 {
+			thenByTypes := make([]seq.TokenizerType, len(q.ThenBy))
+			for j, thenBy := range q.ThenBy {
+				thenByTypes[j] = info.IndexType(thenBy.Field, q.ThenByTypes[j])
+			}
+			q.ThenByTypes = thenByTypes
+		}
+		if q.IntervalField != nil && info.IndexType(q.IntervalField.Field, seq.TokenizerTypeDate) != seq.TokenizerTypeDate {
+			// Documents are placed into time series by their own timestamp.
+			q.IntervalField = nil
+		}
+		aggs[i] = q
+	}
+	params.AggQ = aggs
+	return params
+}
+
+func adaptAST(root *parser.ASTNode, info *Info) *parser.ASTNode {
+	if root == nil {
+		return nil
+	}
+
+	var children []*parser.ASTNode
+	for i, child := range root.Children {
+		adapted := adaptAST(child, info)
+		if adapted != child && children == nil {
+			children = append(make([]*parser.ASTNode, 0, len(root.Children)), root.Children[:i]...)
+		}
+		if children != nil {
+			children = append(children, adapted)
+		}
+	}
+
+	value := adaptToken(root.Value, info)
+	if children == nil && value == root.Value {
+		return root
+	}
+	if children == nil {
+		children = root.Children
+	}
+	return &parser.ASTNode{Value: value, Children: children}
+}
+
+func adaptToken(token parser.Token, info *Info) parser.Token {
+	switch t := token.(type) {
+	case *parser.Range:
+		indexType := info.IndexType(t.Field, t.IndexType)
+		if !seq.IsNumericType(indexType) {
+			indexType = seq.TokenizerTypeNoop
+		}
+		if indexType == t.IndexType {
+			return token
+		}
+		r := *t
+		r.IndexType = indexType
+		return &r
+	case *parser.IPRange:
+		indexType := info.IndexType(t.Field, t.IndexType)
+		if indexType != seq.TokenizerTypeIP {
+			indexType = seq.TokenizerTypeNoop
+		}
+		if indexType == t.IndexType {
+			return token
+		}
+		r := *t
+		r.IndexType = indexType
+		return &r
+	case *parser.Literal:
+		indexType := info.IndexType(t.Field, seq.TokenizerTypeKeyword)
+		if !seq.IsBinaryType(indexType) || t.Field == seq.TokenExists {
+			return adaptLiteralCase(t, info)
+		}
+		filter, err := parser.NewBinaryFilter(t, indexType)
+		if err != nil {
+			// Value can't be represented as a binary token of the field, so literal is searched as is.
+			return token
+		}
+		return filter
+	case *parser.Regexp:
+		caseSensitive := info.IsCaseSensitive(t.Field, t.CaseSensitive)
+		if caseSensitive == t.CaseSensitive {
+			return token
+		}
+		if caseSensitive {
+			return t.IgnoreCase()
+		}
+		r := *t
+		r.CaseSensitive = false
+		return &r
+	case *parser.Substring:
+		caseSensitive := info.IsCaseSensitive(t.Field, t.CaseSensitive)
+		if caseSensitive == t.CaseSensitive {
+			return token
+		}
+		if caseSensitive {
+			// N-grams are stored as is, so they can't be found by lowercased value.
+			return t.IgnoreCaseRegexp()
+		}
+		s := *t
+		s.Value = strings.ToLower(t.Value)
+		s.Grams = make([]string, len(t.Grams))
+		for i, gram := range t.Grams {
+			s.Grams[i] = strings.ToLower(gram)
+		}
+		s.CaseSensitive = false
+		return &s
+	case *parser.Phrase:
+		caseSensitive := info.IsCaseSensitive(t.Field, t.CaseSensitive)
+		if caseSensitive == t.CaseSensitive {
+			return token
+		}
+		p := *t
+		if caseSensitive {
+			p.FoldCase = true
+			return &p
+		}
+		p.Words = make([]string, len(t.Words))
+		for i, word := range t.Words {
+			p.Words[i] = strings.ToLower(word)
+		}
+		p.CaseSensitive = false
+		return &p
+	}
+	return token
+}
+
+// adaptLiteralCase adjusts literal to the case sensitivity of the field in the fraction.
+// Case sensitive query is lowercased for lowercased tokens,
+// and lowercased query is matched ignoring case with tokens stored as is.
+func adaptLiteralCase(literal *parser.Literal, info *Info) parser.Token {
+	caseSensitive := info.IsCaseSensitive(literal.Field, literal.CaseSensitive)
+	if caseSensitive == literal.CaseSensitive {
+		return literal
+	}
+	if len(literal.Terms) == 1 && literal.Terms[0].IsWildcard() {
+		// Any value.
+		return literal
+	}
+	if caseSensitive {
+		return literal.IgnoreCaseRegexp()
+	}
+	l := *literal
+	l.Terms = make([]parser.Term, len(literal.Terms))
+	for i, term := range literal.Terms {
+		if term.Kind == parser.TermText {
+			term.Data = strings.ToLower(term.Data)
+		}
+		l.Terms[i] = term
+	}
+	l.CaseSensitive = false
+	return &l
+}
@@ -0,0 +1,166 @@
+package frac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/frac/processor"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestAdaptSearchParams(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"bytes":     seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+		"client_ip": seq.NewSingleType(seq.TokenizerTypeIP, "", 0),
+		"status":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	query, err := parser.ParseSeqQL("bytes:[*, 100] and client_ip:10.0.0.1 and status:200", mapping)
+	require.NoError(t, err)
+	params := processor.SearchParams{
+		AST: query.Root,
+		AggQ: []processor.AggQuery{{
+			Field:       &parser.Literal{Field: "bytes"},
+			GroupBy:     &parser.Literal{Field: "status"},
+			FieldType:   seq.TokenizerTypeLong,
+			GroupByType: seq.TokenizerTypeKeyword,
+		}},
+	}
+	original := query.SeqQLString()
+
+	// Fraction created before index types were recorded is searched according to the current mapping.
+	adapted := adaptSearchParams(params, &Info{})
+	assert.Same(t, params.AST, adapted.AST)
+
+	// Fraction created with the same mapping.
+	adapted = adaptSearchParams(params, &Info{BinaryFields: seq.BinaryFields(mapping)})
+	assert.Same(t, params.AST, adapted.AST)
+	assert.Equal(t, params.AggQ, adapted.AggQ)
+
+	// Fraction created when all fields were keywords and "status" was long.
+	info := &Info{BinaryFields: map[string]string{"status": "long"}}
+	adapted = adaptSearchParams(params, info)
+	var tokens []parser.Token
+	collectTokens(adapted.AST, &tokens)
+	require.Len(t, tokens, 3)
+
+	bytesRange := tokens[0].(*parser.Range)
+	assert.Equal(t, seq.TokenizerTypeNoop, bytesRange.IndexType)
+	ipRange := tokens[1].(*parser.IPRange)
+	assert.Equal(t, seq.TokenizerTypeNoop, ipRange.IndexType)
+	statusRange := tokens[2].(*parser.Range)
+	assert.Equal(t, seq.TokenizerTypeLong, statusRange.IndexType)
+	assert.Equal(t, "200", statusRange.From.Data)
+	assert.Equal(t, "200", statusRange.To.Data)
+
+	assert.Equal(t, seq.TokenizerTypeKeyword, adapted.AggQ[0].FieldType)
+	assert.Equal(t, seq.TokenizerTypeLong, adapted.AggQ[0].GroupByType)
+
+	// Shared query is not changed.
+	assert.Equal(t, original, query.SeqQLString())
+	assert.Equal(t, seq.TokenizerTypeLong, params.AggQ[0].FieldType)
+}
+
+func TestAdaptSearchParamsCaseSensitive(t *testing.T) {
+	mapping, err := seq.ReadMapping([]byte(`
+mapping-list:
+  - name: level
+    type: keyword
+    case_sensitive: true
+  - name: message
+    type: text
+  - name: k8s_pod
+    type: keyword
+  - name: request
+    type: ngram
+`))
+	require.NoError(t, err)
+	query, err := parser.ParseSeqQL(
+		`level:ERROR and message:phrase("Connection Reset") and k8s_pod:re("API-.*") and request:*Timeout*`, mapping,
+	)
+	require.NoError(t, err)
+	params := processor.SearchParams{AST: query.Root}
+	original := query.SeqQLString()
+
+	// Fraction created with the same mapping.
+	adapted := adaptSearchParams(params, &Info{CaseSensitiveFields: seq.CaseSensitiveFields(mapping, false)})
+	assert.Same(t, params.AST, adapted.AST)
+
+	// Fraction created when "level" was case insensitive and the other fields were case sensitive.
+	info := &Info{CaseSensitiveFields: map[string]bool{
+		"level":   false,
+		"message": true,
+		"k8s_pod": true,
+		"request": true,
+	}}
+	adapted = adaptSearchParams(params, info)
+	var tokens []parser.Token
+	collectTokens(adapted.AST, &tokens)
+	require.Len(t, tokens, 4)
+
+	assert.Equal(t, &parser.Literal{
+		Field: "level",
+		Terms: []parser.Term{{Kind: parser.TermText, Data: "error"}},
+	}, tokens[0])
+	phrase := tokens[1].(*parser.Phrase)
+	assert.True(t, phrase.FoldCase)
+	assert.Equal(t, []string{"connection", "reset"}, phrase.Words)
+	assert.Equal(t, &parser.Regexp{Field: "k8s_pod", Pattern: "(?i:API-.*)", CaseSensitive: true}, tokens[2])
+	assert.Equal(t, &parser.Regexp{Field: "request", Pattern: "(?i:.*timeout.*)", CaseSensitive: true}, tokens[3])
+
+	// Shared query is not changed.
+	assert.Equal(t, original, query.SeqQLString())
+}
+
+func TestAdaptSearchParamsTemplates(t *testing.T) {
+	mapping, err := seq.ReadMapping([]byte(`
+mapping-templates:
+  - match: "*_id"
+    type: keyword
+  - match: "*.duration_ms"
+    type: long
+`))
+	require.NoError(t, err)
+	query, err := parser.ParseSeqQL(`request.duration_ms:[100, *] and user_id:U1`, mapping)
+	require.NoError(t, err)
+	params := processor.SearchParams{AST: query.Root}
+
+	newInfo := func(mapping *seq.Mapping, caseSensitive bool) *Info {
+		return &Info{
+			BinaryFields:        seq.BinaryFields(mapping),
+			CaseSensitiveFields: seq.CaseSensitiveFields(mapping, caseSensitive),
+			Templates:           NewFieldTemplates(mapping, caseSensitive),
+		}
+	}
+
+	// Fraction created with the same mapping.
+	adapted := adaptSearchParams(params, newInfo(mapping, false))
+	assert.Same(t, params.AST, adapted.AST)
+
+	// Fraction created with case sensitive templates and without long one.
+	old, err := seq.ReadMapping([]byte(`
+mapping-templates:
+  - match: "*_id"
+    type: keyword
+`))
+	require.NoError(t, err)
+	adapted = adaptSearchParams(params, newInfo(old, true))
+	var tokens []parser.Token
+	collectTokens(adapted.AST, &tokens)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, seq.TokenizerTypeNoop, tokens[0].(*parser.Range).IndexType)
+	assert.Equal(t, &parser.Regexp{Field: "user_id", Pattern: "(?i:u1)", CaseSensitive: true}, tokens[1])
+}
+
+func collectTokens(root *parser.ASTNode, tokens *[]parser.Token) {
+	if root.Value != nil {
+		if _, ok := root.Value.(*parser.Logical); !ok {
+			*tokens = append(*tokens, root.Value)
+		}
+	}
+	for _, child := range root.Children {
+		collectTokens(child, tokens)
+	}
+}
@@ -37,6 +37,17 @@ type Info struct {
 	CreationTime uint64                `json:"creation_time"`
 	SealingTime  uint64                `json:"sealing_time"`
 	Distribution *seq.MIDsDistribution `json:"distribution"`
+
+	// BinaryFields maps fields with binary encoded tokens (numbers, dates and ip addresses) to their index types.
+	// It is taken from the mapping the fraction is created with and is nil for fractions created before that,
+	// which are searched according to the current mapping.
+	BinaryFields map[string]string `json:"binary_fields"`
+	// CaseSensitiveFields maps fields of string types to their case sensitivity (see seq.MappingType.IsCaseSensitive).
+	// Like BinaryFields, it is taken from the mapping the fraction is created with and is nil for older fractions.
+	CaseSensitiveFields map[string]bool `json:"case_sensitive_fields"`
+	// Templates are the mapping templates the fraction is created with.
+	// Fields which are not in BinaryFields and CaseSensitiveFields are matched with them.
+	Templates []FieldTemplate `json:"templates,omitempty"`
 }
 
 func NewInfo(filename string, docsOnDisk, metaOnDisk uint64) *Info {
@@ -117,3 +128,53 @@ func (s *Info) IsIntersecting(from, to seq.MID) bool {
 	// check with distribution
 	return s.Distribution.IsIntersecting(from, to)
 }
+
+// IsCaseSensitive returns true if values of the field are stored as is in the fraction
+// and false if they are lowercased. If it is unknown, case sensitivity of the query is returned.
+func (s *Info) IsCaseSensitive(field string, queryCase bool) bool {
+	if caseSensitive, ok := s.CaseSensitiveFields[field]; ok {
+		return caseSensitive
+	}
+	if t, ok := s.template(field); ok && seq.IsStringType(seq.NamesToTokenTypes[t.Type]) {
+		return t.CaseSensitive
+	}
+	return queryCase
+}
+
+// template returns the first template matching the field which is not listed in the mapping of the fraction.
+func (s *Info) template(field string) (FieldTemplate, bool) {
+	if _, ok := s.CaseSensitiveFields[field]; ok {
+		return FieldTemplate{}, false
+	}
+	if _, ok := s.BinaryFields[field]; ok {
+		return FieldTemplate{}, false
+	}
+	for _, t := range s.Templates {
+		if seq.MatchFieldPattern(t.Match, field) {
+			return t, true
+		}
+	}
+	return FieldTemplate{}, false
+}
+
+// IndexType returns index type of the field tokens stored in the fraction.
+// Binary index type of the query is replaced with keyword if the field was indexed as text, and vice versa.
+func (s *Info) IndexType(field string, queryType seq.TokenizerType) seq.TokenizerType {
+	if s.BinaryFields == nil {
+		return queryType
+	}
+	if name, ok := s.BinaryFields[field]; ok {
+		return seq.NamesToTokenTypes[name]
+	}
+	if t, ok := s.template(field); ok {
+		if indexType := seq.NamesToTokenTypes[t.Type]; indexType != seq.TokenizerTypeNoop {
+			return indexType
+		}
+		// Field is not indexed, so nothing is found anyway.
+		return queryType
+	}
+	if seq.IsBinaryType(queryType) {
+		return seq.TokenizerTypeKeyword
+	}
+	return queryType
+}
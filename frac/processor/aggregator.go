@@ -39,8 +39,8 @@ type TwoSourceAggregator struct {
 	// groupByNotExists is the map to count non-existent groups by source.
 	// Source (key in the map) actually is an index in groupByTIDs.
 	groupByNotExists map[uint32]int64
-	// collectSamples is a flag to indicate if collect samples is required, this is useful if you need to calculate the quantile.
-	collectSamples bool
+	// quantileError is the relative error of the quantile sketch, samples are collected only if it is set.
+	quantileError float64
 	// countBySource map to count occurrences by histogram source.
 	countBySource map[AggBin[twoSources]]int64
 	// extractMID will be used for building time series.
@@ -49,10 +49,10 @@ type TwoSourceAggregator struct {
 
 func NewGroupAndFieldAggregator(
 	fieldIterator, groupByIterator *SourcedNodeIterator,
-	fn ExtractMIDFunc, collectSamples bool,
+	fn ExtractMIDFunc, quantileError float64,
 ) *TwoSourceAggregator {
 	return &TwoSourceAggregator{
-		collectSamples:   collectSamples,
+		quantileError:    quantileError,
 		countBySource:    make(map[AggBin[twoSources]]int64),
 		field:            fieldIterator,
 		groupNotExists:   0,
@@ -136,8 +136,8 @@ func (n *TwoSourceAggregator) Aggregate() (seq.AggregatableSamples, error) {
 		// The same token can appear multiple times,
 		// so we need to insert the num cnt times.
 		hist.InsertNTimes(num, cnt)
-		if n.collectSamples {
-			hist.InsertSampleNTimes(num, cnt)
+		if n.quantileError > 0 {
+			hist.InsertSampleNTimes(num, cnt, n.quantileError)
 		}
 	}
 
@@ -147,6 +147,191 @@ func (n *TwoSourceAggregator) Aggregate() (seq.AggregatableSamples, error) {
 	}, nil
 }
 
+// groupSources contains sources for the fields grouped by together and the aggregated field.
+type groupSources struct {
+	GroupBySources [seq.MaxGroupByFields]uint32
+	FieldSource    uint32
+}
+
+// MultiGroupAggregator implements Aggregator interface
+// and iterates over several groupBy node.Sourced to aggregate tuples of their values.
+// Values of the tuple are joined into the single token by seq.JoinGroupTokens.
+// Distinct values of the field are counted by the cardinality sketches, the group fields are optional then.
+// Numeric values of the first group field are replaced by the keys of their buckets if they are set.
+type MultiGroupAggregator struct {
+	groupBy []*SourcedNodeIterator
+	// field is nil for count and unique functions.
+	field *SourcedNodeIterator
+	fn    seq.AggFunc
+	// groupNotExists is the counter of documents without any of the group fields.
+	groupNotExists int64
+	// fieldNotExists counts documents of the groups without the field.
+	fieldNotExists map[[seq.MaxGroupByFields]uint32]int64
+	// quantileError is the relative error of the quantile sketch, samples are collected only if it is set.
+	quantileError float64
+	countBySource map[AggBin[groupSources]]int64
+	// hashBySource caches hashes of the field values to count distinct ones.
+	hashBySource map[uint32]uint64
+	buckets      seq.NumericBuckets
+	// bucketBySource caches keys of the buckets of the first group field values.
+	bucketBySource map[uint32]string
+	// extractMID will be used for building time series.
+	extractMID ExtractMIDFunc
+}
+
+func NewMultiGroupAggregator(
+	groupByIterators []*SourcedNodeIterator, fieldIterator *SourcedNodeIterator,
+	aggFunc seq.AggFunc, fn ExtractMIDFunc, quantileError float64, buckets seq.NumericBuckets,
+) *MultiGroupAggregator {
+	if len(groupByIterators) > seq.MaxGroupByFields {
+		panic(fmt.Errorf("BUG: grouping by %d fields, maximum is %d", len(groupByIterators), seq.MaxGroupByFields))
+	}
+	return &MultiGroupAggregator{
+		groupBy:        groupByIterators,
+		field:          fieldIterator,
+		fn:             aggFunc,
+		fieldNotExists: make(map[[seq.MaxGroupByFields]uint32]int64),
+		quantileError:  quantileError,
+		countBySource:  make(map[AggBin[groupSources]]int64),
+		hashBySource:   make(map[uint32]uint64),
+		buckets:        buckets,
+		bucketBySource: make(map[uint32]string),
+		extractMID:     fn,
+	}
+}
+
+// Next iterates over groupBy and field iterators to count occurrence of the tuples.
+func (n *MultiGroupAggregator) Next(lid uint32) error {
+	var sources groupSources
+	hasGroups := true
+	for i, groupBy := range n.groupBy {
+		source, has, err := groupBy.ConsumeTokenSource(lid)
+		if err != nil {
+			return err
+		}
+		hasGroups = hasGroups && has
+		sources.GroupBySources[i] = source
+	}
+
+	hasField := true
+	if n.field != nil {
+		var err error
+		sources.FieldSource, hasField, err = n.field.ConsumeTokenSource(lid)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !hasGroups && hasField:
+		// Some of the group fields do not exist.
+		n.groupNotExists++
+	case !hasGroups:
+		// Neither groups nor field exist.
+	case !hasField:
+		// Groups exist, but field does not.
+		n.fieldNotExists[sources.GroupBySources]++
+	default:
+		n.countBySource[AggBin[groupSources]{
+			MID:    n.extractMID(seq.LID(lid)),
+			Source: sources,
+		}]++
+	}
+	return nil
+}
+
+// Aggregate processes and returns the final aggregation result.
+func (n *MultiGroupAggregator) Aggregate() (seq.AggregatableSamples, error) {
+	aggMap := make(map[seq.AggBin]*seq.SamplesContainer, len(n.countBySource))
+	getHist := func(bin seq.AggBin) *seq.SamplesContainer {
+		if aggMap[bin] == nil {
+			aggMap[bin] = seq.NewSamplesContainers()
+		}
+		return aggMap[bin]
+	}
+
+	for groupBySources, cnt := range n.fieldNotExists {
+		token, err := n.groupToken(groupBySources)
+		if err != nil {
+			return seq.AggregatableSamples{}, err
+		}
+		// Groups of the same bucket are summed up.
+		getHist(seq.AggBin{Token: token}).NotExists += cnt
+	}
+
+	for bin, cnt := range n.countBySource {
+		token, err := n.groupToken(bin.Source.GroupBySources)
+		if err != nil {
+			return seq.AggregatableSamples{}, err
+		}
+		aggBin := seq.AggBin{MID: bin.MID, Token: token}
+		switch n.fn {
+		case seq.AggFuncUnique:
+			aggBin.MID = 0
+			getHist(aggBin)
+		case seq.AggFuncCount:
+			getHist(aggBin).Total += cnt
+		case seq.AggFuncCardinality:
+			hist := getHist(aggBin)
+			hist.Total += cnt
+			hist.InsertDistinct(n.fieldHash(bin.Source.FieldSource))
+		default:
+			num, err := parseNum(n.field.ValueBySource(bin.Source.FieldSource))
+			if err != nil {
+				return seq.AggregatableSamples{}, err
+			}
+			hist := getHist(aggBin)
+			hist.InsertNTimes(num, cnt)
+			if n.quantileError > 0 {
+				hist.InsertSampleNTimes(num, cnt, n.quantileError)
+			}
+		}
+	}
+
+	return seq.AggregatableSamples{
+		NotExists:    n.groupNotExists,
+		SamplesByBin: aggMap,
+	}, nil
+}
+
+func (n *MultiGroupAggregator) fieldHash(source uint32) uint64 {
+	hash, ok := n.hashBySource[source]
+	if !ok {
+		hash = seq.HashValue(n.field.ValueBySource(source))
+		n.hashBySource[source] = hash
+	}
+	return hash
+}
+
+func (n *MultiGroupAggregator) groupToken(sources [seq.MaxGroupByFields]uint32) (string, error) {
+	tokens := make([]string, len(n.groupBy))
+	for i, groupBy := range n.groupBy {
+		if i == 0 && n.buckets.IsSet() {
+			bucket, err := n.bucket(sources[i])
+			if err != nil {
+				return "", err
+			}
+			tokens[i] = bucket
+			continue
+		}
+		tokens[i] = groupBy.ValueBySource(sources[i])
+	}
+	return seq.JoinGroupTokens(tokens), nil
+}
+
+func (n *MultiGroupAggregator) bucket(source uint32) (string, error) {
+	if bucket, ok := n.bucketBySource[source]; ok {
+		return bucket, nil
+	}
+	num, err := parseNum(n.groupBy[0].ValueBySource(source))
+	if err != nil {
+		return "", err
+	}
+	bucket := n.buckets.Key(num)
+	n.bucketBySource[source] = bucket
+	return bucket, nil
+}
+
 func parseNum(str string) (float64, error) {
 	// TODO: allow time.Duration and data units (kb, mb, gb, etc) parsing.
 	num, err := strconv.ParseFloat(str, 64)
@@ -282,21 +467,106 @@ func (n *SingleSourceUniqueAggregator) Aggregate() (seq.AggregatableSamples, err
 	}, nil
 }
 
+// FiltersAggregator counts documents matching each of the filters, the filters are iterated
+// together with the search query, so all of them are evaluated in the same scan.
+type FiltersAggregator struct {
+	names   []string
+	filters []node.Node
+	// lastIDs are the last LIDs of the filters, has[i] is false if the filter i has no more LIDs.
+	lastIDs []uint32
+	has     []bool
+	// countByFilter counts documents by the index of the filter.
+	countByFilter map[AggBin[int]]int64
+	// timeSeries is set if the documents are counted by the time intervals,
+	// otherwise filters without documents have the zero buckets.
+	timeSeries bool
+	// extractMID will be used for building time series.
+	extractMID ExtractMIDFunc
+	less       node.LessFn
+}
+
+func NewFiltersAggregator(
+	names []string, filters []node.Node, fn ExtractMIDFunc, timeSeries, reverse bool,
+) *FiltersAggregator {
+	n := &FiltersAggregator{
+		names:         names,
+		filters:       filters,
+		lastIDs:       make([]uint32, len(filters)),
+		has:           make([]bool, len(filters)),
+		countByFilter: make(map[AggBin[int]]int64),
+		timeSeries:    timeSeries,
+		extractMID:    fn,
+		less:          node.GetLessFn(reverse),
+	}
+	for i, filter := range filters {
+		n.lastIDs[i], n.has[i] = filter.Next()
+	}
+	return n
+}
+
+// Next advances the filters to the lid and counts the ones matching it.
+func (n *FiltersAggregator) Next(lid uint32) error {
+	var mid seq.MID
+	extracted := false
+	for i, filter := range n.filters {
+		for n.has[i] && n.less(n.lastIDs[i], lid) {
+			n.lastIDs[i], n.has[i] = filter.Next()
+		}
+		if !n.has[i] || n.lastIDs[i] != lid {
+			continue
+		}
+		if !extracted {
+			mid = n.extractMID(seq.LID(lid))
+			extracted = true
+		}
+		n.countByFilter[AggBin[int]{MID: mid, Source: i}]++
+	}
+	return nil
+}
+
+func (n *FiltersAggregator) Aggregate() (seq.AggregatableSamples, error) {
+	aggMap := make(map[seq.AggBin]*seq.SamplesContainer, len(n.names))
+
+	if !n.timeSeries {
+		for _, name := range n.names {
+			aggMap[seq.AggBin{Token: name, MID: consts.DummyMID}] = seq.NewSamplesContainers()
+		}
+	}
+
+	for bin, cnt := range n.countByFilter {
+		aggBin := seq.AggBin{
+			Token: n.names[bin.Source],
+			MID:   bin.MID,
+		}
+
+		if aggMap[aggBin] == nil {
+			aggMap[aggBin] = seq.NewSamplesContainers()
+		}
+
+		aggMap[aggBin].Total = cnt
+	}
+
+	return seq.AggregatableSamples{
+		SamplesByBin: aggMap,
+	}, nil
+}
+
 type SingleSourceHistogramAggregator struct {
-	field          *SourcedNodeIterator
-	histogram      map[seq.MID]*seq.SamplesContainer
-	collectSamples bool
-	extractMID     ExtractMIDFunc
+	field     *SourcedNodeIterator
+	histogram map[seq.MID]*seq.SamplesContainer
+	// quantileError is the relative error of the quantile sketch, samples are collected only if it is set.
+	quantileError float64
+	extractMID    ExtractMIDFunc
 }
 
 func NewSingleSourceHistogramAggregator(
-	field *SourcedNodeIterator, collectSamples bool, fn ExtractMIDFunc,
+	field *SourcedNodeIterator, quantileError float64, fn ExtractMIDFunc,
 ) *SingleSourceHistogramAggregator {
 	return &SingleSourceHistogramAggregator{
-		field:          field,
-		histogram:      make(map[seq.MID]*seq.SamplesContainer),
-		collectSamples: collectSamples,
-		extractMID:     fn,
+		field:         field,
+		histogram:     make(map[seq.MID]*seq.SamplesContainer),
+		quantileError: quantileError,
+		extractMID:    fn,
 	}
 }
 
@@ -324,8 +594,8 @@ func (n *SingleSourceHistogramAggregator) Next(lid uint32) error {
 	}
 
 	histogram.InsertNTimes(num, 1)
-	if n.collectSamples {
-		histogram.InsertSample(num)
+	if n.quantileError > 0 {
+		histogram.InsertSampleNTimes(num, 1, n.quantileError)
 	}
 
 	return nil
@@ -350,6 +620,7 @@ type SourcedNodeIterator struct {
 	sourcedNode node.Sourced
 	ti          tokenIndex
 	tids        []uint32
+	indexType   seq.TokenizerType
 
 	tokensCache map[uint32]string
 
@@ -363,12 +634,13 @@ type SourcedNodeIterator struct {
 	less node.LessFn
 }
 
-func NewSourcedNodeIterator(sourced node.Sourced, ti tokenIndex, tids []uint32, limit int, reverse bool) *SourcedNodeIterator {
+func NewSourcedNodeIterator(sourced node.Sourced, ti tokenIndex, tids []uint32, indexType seq.TokenizerType, limit int, reverse bool) *SourcedNodeIterator {
 	lastID, lastSource, has := sourced.NextSourced()
 	return &SourcedNodeIterator{
 		sourcedNode:      sourced,
 		ti:               ti,
 		tids:             tids,
+		indexType:        indexType,
 		tokensCache:      make(map[uint32]string),
 		uniqSourcesLimit: limit,
 		countBySource:    make(map[uint32]int),
@@ -402,17 +674,19 @@ func (s *SourcedNodeIterator) ConsumeTokenSource(lid uint32) (uint32, bool, erro
 	return s.lastSource, true, nil
 }
 
+// ValueBySource returns token value by source.
+// Binary tokens of numeric and ip fields are returned in their text representation.
 func (s *SourcedNodeIterator) ValueBySource(source uint32) string {
 	const useCacheThreshold = 2
 	if s.countBySource[source] < useCacheThreshold {
-		return string(s.ti.GetValByTID(s.tids[source]))
+		return seq.FormatToken(s.indexType, s.ti.GetValByTID(s.tids[source]))
 	}
 
 	val, ok := s.tokensCache[source]
 	if ok {
 		return val
 	}
-	val = string(s.ti.GetValByTID(s.tids[source]))
+	val = seq.FormatToken(s.indexType, s.ti.GetValByTID(s.tids[source]))
 	s.tokensCache[source] = val
 	return val
 }
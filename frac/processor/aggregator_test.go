@@ -27,7 +27,7 @@ func TestSingleSourceCountAggregator(t *testing.T) {
 	}
 
 	source := node.BuildORTreeAgg(node.MakeStaticNodes(sources), false)
-	iter := NewSourcedNodeIterator(source, nil, nil, 0, false)
+	iter := NewSourcedNodeIterator(source, nil, nil, seq.TokenizerTypeKeyword, 0, false)
 	agg := NewSingleSourceCountAggregator(iter, provideExtractTimeFunc(nil, nil, 0))
 	for _, id := range searchDocs {
 		if err := agg.Next(id); err != nil {
@@ -55,7 +55,7 @@ func TestSingleSourceCountAggregatorWithInterval(t *testing.T) {
 	}
 
 	source := node.BuildORTreeAgg(node.MakeStaticNodes(sources), false)
-	iter := NewSourcedNodeIterator(source, nil, nil, 0, false)
+	iter := NewSourcedNodeIterator(source, nil, nil, seq.TokenizerTypeKeyword, 0, false)
 
 	agg := NewSingleSourceCountAggregator(iter, func(l seq.LID) seq.MID {
 		return seq.MID(l) % 3
@@ -90,7 +90,7 @@ func Generate(n int) ([]uint32, uint32) {
 func BenchmarkAggDeep(b *testing.B) {
 	v, _ := Generate(b.N)
 	src := node.NewSourcedNodeWrapper(node.NewStatic(v, false), 0)
-	iter := NewSourcedNodeIterator(src, nil, make([]uint32, 1), 0, false)
+	iter := NewSourcedNodeIterator(src, nil, make([]uint32, 1), seq.TokenizerTypeKeyword, 0, false)
 	n := NewSingleSourceCountAggregator(iter, provideExtractTimeFunc(nil, nil, 0))
 	vals, _ := Generate(b.N)
 	b.ResetTimer()
@@ -115,7 +115,7 @@ func BenchmarkAggWide(b *testing.B) {
 
 	source := node.BuildORTreeAgg(node.MakeStaticNodes(wide), false)
 
-	iter := NewSourcedNodeIterator(source, nil, make([]uint32, len(wide)), 0, false)
+	iter := NewSourcedNodeIterator(source, nil, make([]uint32, len(wide)), seq.TokenizerTypeKeyword, 0, false)
 	n := NewSingleSourceCountAggregator(iter, provideExtractTimeFunc(nil, nil, 0))
 	vals, _ := Generate(b.N)
 	b.ResetTimer()
@@ -177,10 +177,10 @@ func TestTwoSourceAggregator(t *testing.T) {
 
 	fieldTIDs := []uint32{42, 73}
 	groupByTIDs := []uint32{1, 2}
-	groupIterator := NewSourcedNodeIterator(groupBy, dp, groupByTIDs, 0, false)
-	fieldIterator := NewSourcedNodeIterator(field, dp, fieldTIDs, 0, false)
+	groupIterator := NewSourcedNodeIterator(groupBy, dp, groupByTIDs, seq.TokenizerTypeKeyword, 0, false)
+	fieldIterator := NewSourcedNodeIterator(field, dp, fieldTIDs, seq.TokenizerTypeKeyword, 0, false)
 	aggregator := NewGroupAndFieldAggregator(
-		fieldIterator, groupIterator, provideExtractTimeFunc(nil, nil, 0), true,
+		fieldIterator, groupIterator, provideExtractTimeFunc(nil, nil, 0), seq.DefaultQuantileError,
 	)
 
 	// Call Next for two data points.
@@ -218,6 +218,13 @@ func TestTwoSourceAggregator(t *testing.T) {
 	})
 
 	r.Equal(wantBuckets, got.Buckets)
+
+	got = agg.Aggregate(seq.AggregateArgs{
+		Func:      seq.AggFuncQuantile,
+		Quantiles: []float64{0.5},
+	})
+	r.Equal([]float64{42}, got.Buckets[0].Quantiles)
+	r.Equal([]float64{73}, got.Buckets[1].Quantiles)
 }
 
 func TestSingleTreeCountAggregator(t *testing.T) {
@@ -229,7 +236,7 @@ func TestSingleTreeCountAggregator(t *testing.T) {
 		},
 	}
 
-	iter := NewSourcedNodeIterator(field, dp, []uint32{0}, 0, false)
+	iter := NewSourcedNodeIterator(field, dp, []uint32{0}, seq.TokenizerTypeKeyword, 0, false)
 	aggregator := NewSingleSourceCountAggregator(iter, provideExtractTimeFunc(nil, nil, 0))
 
 	r.NoError(aggregator.Next(1))
@@ -251,3 +258,200 @@ func TestSingleTreeCountAggregator(t *testing.T) {
 		r.Equal(hist.Total, result.SamplesByBin[token].Total)
 	}
 }
+
+func TestMultiGroupAggregator(t *testing.T) {
+	r := require.New(t)
+	dp := &MockTokenIndex{}
+
+	newGroups := func() []*SourcedNodeIterator {
+		service := &MockNode{Pairs: []IDSourcePair{
+			{LID: 1, Source: 0}, {LID: 2, Source: 0}, {LID: 3, Source: 1}, {LID: 4, Source: 1},
+		}}
+		level := &MockNode{Pairs: []IDSourcePair{
+			{LID: 1, Source: 0}, {LID: 2, Source: 0}, {LID: 3, Source: 1},
+		}}
+		return []*SourcedNodeIterator{
+			NewSourcedNodeIterator(service, dp, []uint32{1, 2}, seq.TokenizerTypeKeyword, 0, false),
+			NewSourcedNodeIterator(level, dp, []uint32{3, 4}, seq.TokenizerTypeKeyword, 0, false),
+		}
+	}
+	group := func(values ...string) string {
+		return seq.JoinGroupTokens(values)
+	}
+	args := seq.AggregateArgs{GroupByFields: 2}
+
+	aggregator := NewMultiGroupAggregator(newGroups(), nil, seq.AggFuncCount, provideExtractTimeFunc(nil, nil, 0), 0, seq.NumericBuckets{})
+	for lid := uint32(1); lid <= 4; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err := aggregator.Aggregate()
+	r.NoError(err)
+	// Document 4 has no level.
+	r.Equal(int64(1), samples.NotExists)
+
+	args.Func = seq.AggFuncCount
+	r.Equal([]seq.AggregationBucket{
+		{Name: "1, 3", Value: 2, Keys: []string{"1", "3"}},
+		{Name: "2, 4", Value: 1, Keys: []string{"2", "4"}},
+	}, samples.Aggregate(args).Buckets)
+
+	field := &MockNode{Pairs: []IDSourcePair{
+		{LID: 1, Source: 0}, {LID: 2, Source: 1}, {LID: 4, Source: 1},
+	}}
+	aggregator = NewMultiGroupAggregator(
+		newGroups(), NewSourcedNodeIterator(field, dp, []uint32{10, 20}, seq.TokenizerTypeKeyword, 0, false),
+		seq.AggFuncSum, provideExtractTimeFunc(nil, nil, 0), 0, seq.NumericBuckets{},
+	)
+	for lid := uint32(1); lid <= 4; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err = aggregator.Aggregate()
+	r.NoError(err)
+	r.Equal(int64(1), samples.NotExists)
+	r.Equal(int64(1), samples.SamplesByBin[seq.AggBin{Token: group("2", "4")}].NotExists)
+
+	args.Func = seq.AggFuncSum
+	buckets := samples.Aggregate(args).Buckets
+	r.Len(buckets, 2)
+	r.Equal(seq.AggregationBucket{Name: "1, 3", Value: 30, Keys: []string{"1", "3"}}, buckets[0])
+	// The group exists, but its documents have no field.
+	r.Equal("2, 4", buckets[1].Name)
+	r.Equal(int64(1), buckets[1].NotExists)
+	r.True(math.IsNaN(buckets[1].Value))
+}
+
+func TestMultiGroupAggregatorCardinality(t *testing.T) {
+	r := require.New(t)
+	dp := &MockTokenIndex{}
+
+	newField := func() *SourcedNodeIterator {
+		user := &MockNode{Pairs: []IDSourcePair{
+			{LID: 1, Source: 0}, {LID: 2, Source: 1}, {LID: 3, Source: 0}, {LID: 4, Source: 0},
+		}}
+		return NewSourcedNodeIterator(user, dp, []uint32{10, 20}, seq.TokenizerTypeKeyword, 0, false)
+	}
+	service := &MockNode{Pairs: []IDSourcePair{
+		{LID: 1, Source: 0}, {LID: 2, Source: 0}, {LID: 3, Source: 1}, {LID: 4, Source: 1}, {LID: 5, Source: 1},
+	}}
+	groups := []*SourcedNodeIterator{NewSourcedNodeIterator(service, dp, []uint32{1, 2}, seq.TokenizerTypeKeyword, 0, false)}
+	args := seq.AggregateArgs{Func: seq.AggFuncCardinality}
+
+	aggregator := NewMultiGroupAggregator(groups, newField(), seq.AggFuncCardinality, provideExtractTimeFunc(nil, nil, 0), 0, seq.NumericBuckets{})
+	for lid := uint32(1); lid <= 5; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err := aggregator.Aggregate()
+	r.NoError(err)
+	// Document 5 has no user.
+	r.Equal([]seq.AggregationBucket{
+		{Name: "1", Value: 2},
+		{Name: "2", Value: 1, NotExists: 1},
+	}, samples.Aggregate(args).Buckets)
+
+	// Distinct values of all the documents are counted without the group fields.
+	aggregator = NewMultiGroupAggregator(nil, newField(), seq.AggFuncCardinality, provideExtractTimeFunc(nil, nil, 0), 0, seq.NumericBuckets{})
+	for lid := uint32(1); lid <= 5; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err = aggregator.Aggregate()
+	r.NoError(err)
+	r.Equal([]seq.AggregationBucket{{Value: 2, NotExists: 1}}, samples.Aggregate(args).Buckets)
+}
+
+func TestMultiGroupAggregatorBuckets(t *testing.T) {
+	r := require.New(t)
+	dp := &MockTokenIndex{}
+
+	newLatency := func() []*SourcedNodeIterator {
+		latency := &MockNode{Pairs: []IDSourcePair{
+			{LID: 1, Source: 0}, {LID: 2, Source: 1}, {LID: 3, Source: 2}, {LID: 4, Source: 3}, {LID: 5, Source: 3},
+		}}
+		return []*SourcedNodeIterator{
+			NewSourcedNodeIterator(latency, dp, []uint32{50, 150, 450, 700}, seq.TokenizerTypeKeyword, 0, false),
+		}
+	}
+	buckets := seq.NumericBuckets{Ranges: []float64{100, 500}}
+	args := seq.AggregateArgs{Buckets: buckets}
+
+	aggregator := NewMultiGroupAggregator(newLatency(), nil, seq.AggFuncCount, provideExtractTimeFunc(nil, nil, 0), 0, buckets)
+	for lid := uint32(1); lid <= 5; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err := aggregator.Aggregate()
+	r.NoError(err)
+
+	args.Func = seq.AggFuncCount
+	r.Equal([]seq.AggregationBucket{
+		{Name: "[-inf,100)", Value: 1},
+		{Name: "[100,500)", Value: 2},
+		{Name: "[500,+inf)", Value: 2},
+	}, samples.Aggregate(args).Buckets)
+
+	// Metrics of the field are calculated by the buckets.
+	size := &MockNode{Pairs: []IDSourcePair{
+		{LID: 1, Source: 0}, {LID: 2, Source: 0}, {LID: 3, Source: 1}, {LID: 5, Source: 1},
+	}}
+	aggregator = NewMultiGroupAggregator(
+		newLatency(), NewSourcedNodeIterator(size, dp, []uint32{10, 20}, seq.TokenizerTypeKeyword, 0, false),
+		seq.AggFuncAvg, provideExtractTimeFunc(nil, nil, 0), 0, seq.NumericBuckets{BinWidth: 200},
+	)
+	for lid := uint32(1); lid <= 5; lid++ {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err = aggregator.Aggregate()
+	r.NoError(err)
+
+	args = seq.AggregateArgs{Func: seq.AggFuncAvg, Buckets: seq.NumericBuckets{BinWidth: 200}}
+	r.Equal([]seq.AggregationBucket{
+		{Name: "0", Value: 10},
+		{Name: "400", Value: 20},
+		{Name: "600", Value: 20, NotExists: 1},
+	}, samples.Aggregate(args).Buckets)
+}
+
+func TestFiltersAggregator(t *testing.T) {
+	r := require.New(t)
+	names := []string{"errors", "timeouts", "empty"}
+	filters := [][]uint32{{1, 2, 4, 7, 9}, {2, 3, 9}, {5}}
+	searchDocs := []uint32{2, 3, 4, 6, 9}
+
+	for _, reverse := range []bool{false, true} {
+		docs := slices.Clone(searchDocs)
+		if reverse {
+			slices.Reverse(docs)
+		}
+		nodes := make([]node.Node, len(filters))
+		for i := range filters {
+			nodes[i] = node.NewStatic(filters[i], reverse)
+		}
+
+		aggregator := NewFiltersAggregator(names, nodes, provideExtractTimeFunc(nil, nil, 0), false, reverse)
+		for _, lid := range docs {
+			r.NoError(aggregator.Next(lid))
+		}
+		samples, err := aggregator.Aggregate()
+		r.NoError(err)
+
+		// Filters are ordered like in the query, the ones without documents have the zero buckets.
+		r.Equal([]seq.AggregationBucket{
+			{Name: "errors", Value: 3},
+			{Name: "timeouts", Value: 3},
+			{Name: "empty", Value: 0},
+		}, samples.Aggregate(seq.AggregateArgs{Func: seq.AggFuncCount, Filters: names}).Buckets)
+	}
+
+	extractMID := func(lid seq.LID) seq.MID { return seq.MID(lid / 5 * 5000) }
+	aggregator := NewFiltersAggregator(names, node.MakeStaticNodes(filters), extractMID, true, false)
+	for _, lid := range searchDocs {
+		r.NoError(aggregator.Next(lid))
+	}
+	samples, err := aggregator.Aggregate()
+	r.NoError(err)
+
+	r.Equal([]seq.AggregationBucket{
+		{Name: "errors", Value: 2},
+		{Name: "timeouts", Value: 2},
+		{Name: "errors", Value: 1, MID: 5000},
+		{Name: "timeouts", Value: 1, MID: 5000},
+	}, samples.Aggregate(seq.AggregateArgs{Func: seq.AggFuncCount, Filters: names}).Buckets)
+}
@@ -3,12 +3,16 @@ package processor
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ozontech/seq-db/frac/sealed/lids"
+	"github.com/ozontech/seq-db/metric/stopwatch"
 	"github.com/ozontech/seq-db/node"
 	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
 )
 
 type staticProvider struct {
@@ -92,3 +96,68 @@ func TestEval(t *testing.T) {
 		assert.Equal(t, []uint32{1, 2, 4, 5, 7, 8, 11, 12}, readAll(root))
 	})
 }
+
+// dateTokenIndex is a token index of a single date field, where each TID has its own list of LIDs.
+type dateTokenIndex struct {
+	tokenIndex
+	dates []time.Time
+	lids  [][]uint32
+}
+
+func (ti *dateTokenIndex) GetValByTID(tid uint32) []byte {
+	return seq.AppendDate(nil, ti.dates[tid])
+}
+
+func (ti *dateTokenIndex) GetTIDsByTokenExpr(parser.Token) ([]uint32, error) {
+	tids := make([]uint32, len(ti.dates))
+	for i := range tids {
+		tids[i] = uint32(i)
+	}
+	return tids, nil
+}
+
+func (ti *dateTokenIndex) GetLIDsFromTIDs(tids []uint32, _ lids.Counter, _, _ uint32, _ seq.DocsOrder) []node.Node {
+	data := make([][]uint32, 0, len(tids))
+	for _, tid := range tids {
+		data = append(data, ti.lids[tid])
+	}
+	return node.MakeStaticNodes(data)
+}
+
+func TestExtractFieldTime(t *testing.T) {
+	ti := &dateTokenIndex{
+		dates: []time.Time{
+			time.Date(2024, 1, 1, 10, 1, 30, 0, time.UTC),
+			time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC),
+			// Dates before the epoch are placed into the first bucket.
+			time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		lids: [][]uint32{{1, 4}, {2}, {5}},
+	}
+
+	const fallbackMID = seq.MID(42)
+	fallback := ExtractMIDFunc(func(seq.LID) seq.MID { return fallbackMID })
+
+	interval := int64(time.Minute / time.Millisecond)
+	field := &parser.Literal{Field: "started_at", Terms: []parser.Term{{Kind: parser.TermSymbol, Data: "*"}}}
+	extract, err := provideExtractFieldTimeFunc(
+		ti, field, stopwatch.New(), &searchStats{}, 1, 5, 0, interval, seq.DocsOrderDesc, fallback,
+	)
+	require.NoError(t, err)
+
+	minute := func(ts time.Time) seq.MID {
+		return seq.MID(ts.Truncate(time.Minute).UnixMilli())
+	}
+	expected := []seq.MID{
+		minute(ti.dates[0]),
+		minute(ti.dates[1]),
+		// Document without the field falls back to its own timestamp.
+		fallbackMID,
+		minute(ti.dates[0]),
+		0,
+	}
+	for i, mid := range expected {
+		lid := seq.LID(i + 1)
+		assert.Equal(t, mid, extract(lid), "lid %d", lid)
+	}
+}
@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"cmp"
 	"fmt"
 
 	"github.com/ozontech/seq-db/consts"
@@ -30,6 +31,12 @@ func buildEvalTree(root *parser.ASTNode, minVal, maxVal uint32, stats *searchSta
 		return newLeaf(token)
 	case *parser.IPRange:
 		return newLeaf(token)
+	case *parser.Substring:
+		return newLeaf(token)
+	case *parser.Phrase:
+		return newLeaf(token)
+	case *parser.Regexp:
+		return newLeaf(token)
 	case *parser.Logical:
 		switch token.Operator {
 		case parser.LogicalAnd:
@@ -87,15 +94,34 @@ type AggLimits struct {
 }
 
 // evalAgg evaluates aggregation with given limits. Returns a suitable aggregator.
+// Eval trees of the filters of the aggregation are built with newLeaf like the one of the search query.
 func evalAgg(
 	ti tokenIndex, query AggQuery, sw *stopwatch.Stopwatch,
 	stats *searchStats, minLID, maxLID uint32, limits AggLimits,
-	extractMID ExtractMIDFunc, order seq.DocsOrder,
+	extractMID ExtractMIDFunc, order seq.DocsOrder, newLeaf createLeafFunc,
 ) (Aggregator, error) {
+	if len(query.Filters) > 0 {
+		names := make([]string, len(query.Filters))
+		filters := make([]node.Node, len(query.Filters))
+		for i, filter := range query.Filters {
+			var err error
+			filters[i], err = buildEvalTree(filter.AST, minLID, maxLID, stats, order.IsReverse(), newLeaf)
+			if err != nil {
+				return nil, fmt.Errorf("building eval tree of filter %q: %w", filter.Name, err)
+			}
+			names[i] = filter.Name
+		}
+		return NewFiltersAggregator(names, filters, extractMID, query.Interval > 0, order.IsReverse()), nil
+	}
+
+	if len(query.ThenBy) > 0 || query.Func == seq.AggFuncCardinality || query.Buckets.IsSet() {
+		return evalMultiGroupAgg(ti, query, sw, stats, minLID, maxLID, limits, extractMID, order)
+	}
+
 	switch query.Func {
 	case seq.AggFuncCount, seq.AggFuncUnique:
 		groupIterator, err := iteratorFromLiteral(
-			ti, query.GroupBy, sw, stats, minLID, maxLID,
+			ti, query.GroupBy, query.GroupByType, sw, stats, minLID, maxLID,
 			limits.MaxTIDsPerFraction, limits.MaxGroupTokens, order,
 		)
 		if err != nil {
@@ -110,24 +136,21 @@ func evalAgg(
 
 	case seq.AggFuncMin, seq.AggFuncMax, seq.AggFuncSum, seq.AggFuncAvg, seq.AggFuncQuantile:
 		fieldIterator, err := iteratorFromLiteral(
-			ti, query.Field, sw, stats, minLID, maxLID,
+			ti, query.Field, query.FieldType, sw, stats, minLID, maxLID,
 			limits.MaxTIDsPerFraction, limits.MaxFieldTokens, order,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		collectSamples := query.Func == seq.AggFuncQuantile &&
-			haveNotMinMaxQuantiles(query.Quantiles)
-
 		if query.GroupBy == nil {
 			return NewSingleSourceHistogramAggregator(
-				fieldIterator, collectSamples, extractMID,
+				fieldIterator, quantileError(query), extractMID,
 			), nil
 		}
 
 		groupIterator, err := iteratorFromLiteral(
-			ti, query.GroupBy, sw, stats, minLID, maxLID,
+			ti, query.GroupBy, query.GroupByType, sw, stats, minLID, maxLID,
 			limits.MaxTIDsPerFraction, limits.MaxGroupTokens, order,
 		)
 		if err != nil {
@@ -135,7 +158,7 @@ func evalAgg(
 		}
 
 		return NewGroupAndFieldAggregator(
-			fieldIterator, groupIterator, extractMID, collectSamples,
+			fieldIterator, groupIterator, extractMID, quantileError(query),
 		), nil
 
 	default:
@@ -143,6 +166,73 @@ func evalAgg(
 	}
 }
 
+// evalMultiGroupAgg creates aggregator of the tuples of GroupBy and ThenBy fields values.
+// It also counts distinct values of the field by the groups, which may be not set then,
+// and buckets numeric values of GroupBy.
+func evalMultiGroupAgg(
+	ti tokenIndex, query AggQuery, sw *stopwatch.Stopwatch,
+	stats *searchStats, minLID, maxLID uint32, limits AggLimits,
+	extractMID ExtractMIDFunc, order seq.DocsOrder,
+) (Aggregator, error) {
+	var groupBy []*parser.Literal
+	var groupByTypes []seq.TokenizerType
+	if query.GroupBy != nil {
+		groupBy = append([]*parser.Literal{query.GroupBy}, query.ThenBy...)
+		groupByTypes = append([]seq.TokenizerType{query.GroupByType}, query.ThenByTypes...)
+	}
+
+	groupIterators := make([]*SourcedNodeIterator, len(groupBy))
+	for i, literal := range groupBy {
+		groupTokens := limits.MaxGroupTokens
+		if i == 0 && query.Buckets.IsSet() {
+			// Numeric values are parsed like the ones of the field, the number of the buckets is limited by the groups limit.
+			groupTokens = limits.MaxFieldTokens
+		}
+		var err error
+		groupIterators[i], err = iteratorFromLiteral(
+			ti, literal, groupByTypes[i], sw, stats, minLID, maxLID,
+			limits.MaxTIDsPerFraction, groupTokens, order,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fieldIterator *SourcedNodeIterator
+	switch query.Func {
+	case seq.AggFuncCount, seq.AggFuncUnique:
+	case seq.AggFuncMin, seq.AggFuncMax, seq.AggFuncSum, seq.AggFuncAvg, seq.AggFuncQuantile, seq.AggFuncCardinality:
+		fieldTokens := limits.MaxFieldTokens
+		if query.Func == seq.AggFuncCardinality {
+			// Distinct values are counted by the sketches, so their number is not limited.
+			fieldTokens = 0
+		}
+		var err error
+		fieldIterator, err = iteratorFromLiteral(
+			ti, query.Field, query.FieldType, sw, stats, minLID, maxLID,
+			limits.MaxTIDsPerFraction, fieldTokens, order,
+		)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		panic(fmt.Errorf("BUG: unknown aggregation function: %d", query.Func))
+	}
+
+	return NewMultiGroupAggregator(
+		groupIterators, fieldIterator, query.Func, extractMID, quantileError(query), query.Buckets,
+	), nil
+}
+
+// quantileError returns the relative error of the quantile sketch,
+// it is zero if the query needs no samples to calculate quantiles.
+func quantileError(query AggQuery) float64 {
+	if query.Func != seq.AggFuncQuantile || !haveNotMinMaxQuantiles(query.Quantiles) {
+		return 0
+	}
+	return cmp.Or(query.QuantileError, seq.DefaultQuantileError)
+}
+
 // haveNotMinMaxQuantiles returns true if any quantile is in the range (0.0, 1.0)
 func haveNotMinMaxQuantiles(quantiles []float64) bool {
 	have := false
@@ -157,7 +247,7 @@ func haveNotMinMaxQuantiles(quantiles []float64) bool {
 	return have
 }
 
-func iteratorFromLiteral(ti tokenIndex, literal *parser.Literal, sw *stopwatch.Stopwatch, stats *searchStats, minLID, maxLID uint32, maxTIDs, iteratorLimit int, order seq.DocsOrder) (*SourcedNodeIterator, error) {
+func iteratorFromLiteral(ti tokenIndex, literal *parser.Literal, indexType seq.TokenizerType, sw *stopwatch.Stopwatch, stats *searchStats, minLID, maxLID uint32, maxTIDs, iteratorLimit int, order seq.DocsOrder) (*SourcedNodeIterator, error) {
 	m := sw.Start("get_tids_by_token_expr")
 	tids, err := ti.GetTIDsByTokenExpr(literal)
 	m.Stop()
@@ -178,5 +268,36 @@ func iteratorFromLiteral(ti tokenIndex, literal *parser.Literal, sw *stopwatch.S
 	}
 
 	sourcedNode := node.BuildORTreeAgg(lidsTids, order.IsReverse())
-	return NewSourcedNodeIterator(sourcedNode, ti, tids, iteratorLimit, order.IsReverse()), nil
+	return NewSourcedNodeIterator(sourcedNode, ti, tids, indexType, iteratorLimit, order.IsReverse()), nil
+}
+
+// provideExtractFieldTimeFunc returns ExtractMIDFunc that takes time from the date field instead of document ID.
+// Documents without the field are placed into time series by their own timestamp using fallback.
+func provideExtractFieldTimeFunc(
+	ti tokenIndex, field *parser.Literal, sw *stopwatch.Stopwatch, stats *searchStats,
+	minLID, maxLID uint32, maxTIDs int, interval int64, order seq.DocsOrder, fallback ExtractMIDFunc,
+) (ExtractMIDFunc, error) {
+	iterator, err := iteratorFromLiteral(
+		ti, field, seq.TokenizerTypeDate, sw, stats, minLID, maxLID, maxTIDs, 0, order,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	midBySource := make(map[uint32]seq.MID)
+	return ExtractMIDFunc(func(lid seq.LID) seq.MID {
+		source, has, _ := iterator.ConsumeTokenSource(uint32(lid))
+		if !has {
+			return fallback(lid)
+		}
+
+		mid, ok := midBySource[source]
+		if !ok {
+			date, _ := seq.DecodeDate(ti.GetValByTID(iterator.tids[source]))
+			mid = seq.MID(max(date.UnixMilli(), 0))
+			mid -= mid % seq.MID(interval)
+			midBySource[source] = mid
+		}
+		return mid
+	}), nil
 }
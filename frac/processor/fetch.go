@@ -5,21 +5,32 @@ import (
 	"github.com/ozontech/seq-db/seq"
 )
 
-type fetchIndex interface {
+type docsReader interface {
 	GetBlocksOffsets(uint32) uint64
-	GetDocPos([]seq.ID) []seq.DocPos
 	ReadDocs(blockOffset uint64, docOffsets []uint64) ([][]byte, error)
 }
 
+type fetchIndex interface {
+	docsReader
+	GetDocPos([]seq.ID) []seq.DocPos
+}
+
 func IndexFetch(ids []seq.ID, sw *stopwatch.Stopwatch, fetchIndex fetchIndex, res [][]byte) error {
 	m := sw.Start("get_docs_pos")
 	docsPos := fetchIndex.GetDocPos(ids)
-	blocks, offsets, index := seq.GroupDocsOffsets(docsPos)
 	m.Stop()
 
 	m = sw.Start("read_doc")
+	err := readDocs(fetchIndex, docsPos, res)
+	m.Stop()
+	return err
+}
+
+// readDocs reads documents by their positions into res, documents that are not found are left nil.
+func readDocs(reader docsReader, docsPos []seq.DocPos, res [][]byte) error {
+	blocks, offsets, index := seq.GroupDocsOffsets(docsPos)
 	for i, docOffsets := range offsets {
-		docs, err := fetchIndex.ReadDocs(fetchIndex.GetBlocksOffsets(blocks[i]), docOffsets)
+		docs, err := reader.ReadDocs(reader.GetBlocksOffsets(blocks[i]), docOffsets)
 		if err != nil {
 			return err
 		}
@@ -27,6 +38,5 @@ func IndexFetch(ids []seq.ID, sw *stopwatch.Stopwatch, fetchIndex fetchIndex, re
 			res[dst] = docs[src]
 		}
 	}
-	m.Stop()
 	return nil
 }
@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"bytes"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-db/analyzer"
+	"github.com/ozontech/seq-db/metric/stopwatch"
+	"github.com/ozontech/seq-db/node"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// evalPhrase returns Node that generates LIDs of documents containing all words of the phrase
+// and then verifies that the words are located next to each other.
+func evalPhrase(
+	index searchIndex, token *parser.Phrase, sw *stopwatch.Stopwatch, budget *verifyBudget,
+	stats *searchStats, minLID, maxLID uint32, order seq.DocsOrder,
+) (node.Node, error) {
+	root, err := evalTermsConjunction(index, token.Field, token.Words, token.FoldCase, sw, stats, minLID, maxLID, order)
+	if err != nil {
+		return nil, err
+	}
+
+	words := make([][]byte, len(token.Words))
+	for i, w := range token.Words {
+		words[i] = []byte(w)
+	}
+	return newVerifyNode(root, index, sw, budget, token.Source, token.CaseSensitive, func(value []byte) bool {
+		return containsPhrase(value, words, token.Analyzer)
+	}), nil
+}
+
+// containsPhrase checks if text contains words next to each other.
+// Text is split into words the same way as the text tokenizer does.
+// If analyzer is set, it is applied to the words of the text and rejected words are skipped.
+func containsPhrase(text []byte, words [][]byte, a *analyzer.Analyzer) bool {
+	window := make([][]byte, 0, len(words))
+	for {
+		var word []byte
+		word, text = nextWord(text)
+		if word == nil {
+			return false
+		}
+		if a != nil {
+			var ok bool
+			if word, ok = a.Analyze(word); !ok {
+				continue
+			}
+		}
+		if len(window) == len(words) {
+			window = append(window[:0], window[1:]...)
+		}
+		window = append(window, word)
+		if len(window) == len(words) && slices.EqualFunc(window, words, bytes.Equal) {
+			return true
+		}
+	}
+}
+
+// nextWord returns the first word of the text and the rest of the text.
+// Word is a sequence of letters, numbers, '_' and '*'. It returns nil if there are no more words.
+func nextWord(text []byte) ([]byte, []byte) {
+	start := -1
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		isWordRune := unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' || r == '*'
+		switch {
+		case isWordRune && start < 0:
+			start = i
+		case !isWordRune && start >= 0:
+			return text[start:i], text[i:]
+		}
+		i += size
+	}
+	if start < 0 {
+		return nil, nil
+	}
+	return text[start:], nil
+}
@@ -0,0 +1,43 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/analyzer"
+)
+
+func TestContainsPhrase(t *testing.T) {
+	test := func(text, phrase string, expected bool) {
+		t.Helper()
+		var words [][]byte
+		for _, w := range strings.Fields(phrase) {
+			words = append(words, []byte(w))
+		}
+		assert.Equal(t, expected, containsPhrase([]byte(text), words, nil), "%q in %q", phrase, text)
+	}
+
+	test("connection reset by peer", "reset by", true)
+	test("connection reset by peer", "connection reset by peer", true)
+	test("read: connection, reset (by) peer", "connection reset by peer", true)
+	test("peer reset connection by", "reset by", false)
+	test("reset", "reset by", false)
+	test("a a a b", "a a b", true)
+	test("a a c a b", "a a b", false)
+	test("ошибка: соединение сброшено", "соединение сброшено", true)
+	test("user_id=42 not found", "user_id 42", true)
+	test("", "a b", false)
+}
+
+func TestContainsPhraseAnalyzer(t *testing.T) {
+	a, err := analyzer.New("test", analyzer.Config{Stopwords: []string{"_russian_"}, Stemmer: analyzer.StemmerRussian})
+	require.NoError(t, err)
+
+	words := [][]byte{[]byte("ошибк"), []byte("подключен")}
+	assert.True(t, containsPhrase([]byte("Ошибки при подключении к базе"), words, a))
+	assert.True(t, containsPhrase([]byte("ошибка подключения"), words, a))
+	assert.False(t, containsPhrase([]byte("ошибка базы при подключении"), words, a))
+}
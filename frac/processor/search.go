@@ -2,6 +2,7 @@ package processor
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -32,9 +33,16 @@ type tokenIndex interface {
 	GetLIDsFromTIDs(tids []uint32, stats lids.Counter, minLID, maxLID uint32, order seq.DocsOrder) []node.Node
 }
 
+// docsIndex provides access to documents by seq.LID, it is used to verify documents found by index.
+type docsIndex interface {
+	docsReader
+	GetDocPosByLIDs([]seq.LID) []seq.DocPos
+}
+
 type searchIndex interface {
 	tokenIndex
 	idsIndex
+	docsIndex
 }
 
 func IndexSearch(
@@ -42,6 +50,7 @@ func IndexSearch(
 	params SearchParams,
 	index searchIndex,
 	aggLimits AggLimits,
+	maxVerifiedDocs int,
 	sw *stopwatch.Stopwatch,
 ) (*seq.QPR, error) {
 	stats := &searchStats{}
@@ -50,12 +59,30 @@ func IndexSearch(
 	minLID, maxLID := getLIDsBorders(params.From, params.To, index)
 	m.Stop()
 
-	m = sw.Start("eval_leaf")
-	evalTree, err := buildEvalTree(params.AST, minLID, maxLID, stats, params.Order.IsReverse(),
-		func(token parser.Token) (node.Node, error) {
+	var verifiers []*verifyNode
+	budget := &verifyBudget{ctx: ctx, limit: maxVerifiedDocs}
+
+	newLeaf := func(token parser.Token) (node.Node, error) {
+		var (
+			n   node.Node
+			err error
+		)
+		switch t := token.(type) {
+		case *parser.Substring:
+			n, err = evalSubstring(index, t, sw, budget, stats, minLID, maxLID, params.Order)
+		case *parser.Phrase:
+			n, err = evalPhrase(index, t, sw, budget, stats, minLID, maxLID, params.Order)
+		default:
 			return evalLeaf(index, token, sw, stats, minLID, maxLID, params.Order)
-		},
-	)
+		}
+		if v, ok := n.(*verifyNode); ok {
+			verifiers = append(verifiers, v)
+		}
+		return n, err
+	}
+
+	m = sw.Start("eval_leaf")
+	evalTree, err := buildEvalTree(params.AST, minLID, maxLID, stats, params.Order.IsReverse(), newLeaf)
 	m.Stop()
 
 	if err != nil {
@@ -72,9 +99,20 @@ func IndexSearch(
 	if params.HasAgg() {
 		m = sw.Start("eval_agg")
 		for i, query := range params.AggQ {
+			extractMID := provideExtractTimeFunc(sw, index, query.Interval)
+			if query.IntervalField != nil && query.Interval > 0 {
+				extractMID, err = provideExtractFieldTimeFunc(
+					index, query.IntervalField, sw, stats, minLID, maxLID,
+					aggLimits.MaxTIDsPerFraction, query.Interval, params.Order, extractMID,
+				)
+				if err != nil {
+					m.Stop()
+					return nil, err
+				}
+			}
 			aggs[i], err = evalAgg(
 				index, query, sw, stats, minLID, maxLID, aggLimits,
-				provideExtractTimeFunc(sw, index, query.Interval), params.Order,
+				extractMID, params.Order, newLeaf,
 			)
 			if err != nil {
 				m.Stop()
@@ -84,14 +122,30 @@ func IndexSearch(
 		m.Stop()
 	}
 
+	var sorter *docsSorter
+	if params.HasSort() && params.Limit > 0 {
+		m = sw.Start("eval_sort")
+		sorter, err = newDocsSorter(index, params, sw, stats, minLID, maxLID)
+		m.Stop()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	m = sw.Start("iterate_eval_tree")
-	total, ids, histogram, err := iterateEvalTree(ctx, params, index, evalTree, aggs, sw)
+	total, ids, histogram, err := iterateEvalTree(ctx, params, index, evalTree, aggs, sorter, sw)
 	m.Stop()
 
 	if err != nil {
 		return nil, err
 	}
 
+	for _, v := range verifiers {
+		if v.err != nil {
+			return nil, fmt.Errorf("verifying documents: %w", v.err)
+		}
+	}
+
 	stats.HitsTotal += total
 
 	var aggsResult []seq.AggregatableSamples
@@ -133,6 +187,7 @@ func iterateEvalTree(
 	idsIndex idsIndex,
 	evalTree node.Node,
 	aggs []Aggregator,
+	sorter *docsSorter,
 	sw *stopwatch.Stopwatch,
 ) (int, seq.IDSources, map[seq.MID]uint64, error) {
 	hasHist := params.HasHist()
@@ -153,7 +208,8 @@ func iterateEvalTree(
 			return total, ids, histogram, ctx.Err()
 		}
 
-		needMore := len(ids) < params.Limit
+		// Sorter collects ids by itself.
+		needMore := len(ids) < params.Limit && sorter == nil
 		if !needMore && !needScanAllRange {
 			break
 		}
@@ -193,6 +249,15 @@ func iterateEvalTree(
 
 		total++ // increment found counter, use aggNode, calculate histogram and collect ids only if id in borders
 
+		if sorter != nil {
+			m = sw.Start("sort_docs")
+			err := sorter.Next(lid, idsIndex)
+			m.Stop()
+			if err != nil {
+				return total, ids, histogram, err
+			}
+		}
+
 		if len(aggs) > 0 {
 			m = sw.Start("agg_node_count")
 			for i := range aggs {
@@ -205,6 +270,10 @@ func iterateEvalTree(
 
 	}
 
+	if sorter != nil {
+		ids = sorter.IDs()
+	}
+
 	return total, ids, histogram, nil
 }
 
@@ -240,7 +309,7 @@ func MergeQPRs(qprs []*seq.QPR, params SearchParams) *seq.QPR {
 	}
 	qpr := qprs[0]
 	if len(qprs) > 1 {
-		seq.MergeQPRs(qpr, qprs[1:], params.Limit, seq.MID(params.HistInterval), params.Order)
+		seq.MergeQPRs(qpr, qprs[1:], params.Limit, seq.MID(params.HistInterval), params.Order, params.SortBy())
 	}
 	return qpr
 }
@@ -10,7 +10,37 @@ type AggQuery struct {
 	GroupBy   *parser.Literal
 	Func      seq.AggFunc
 	Quantiles []float64
-	Interval  int64
+	// QuantileError is the relative error of the quantiles, the default one is used if it is zero.
+	QuantileError float64
+	Interval      int64
+	// IntervalField is a date field used to build time series instead of document timestamp.
+	IntervalField *parser.Literal
+	// FieldType and GroupByType are index types of Field and GroupBy.
+	// They are used to decode binary tokens of numeric and ip fields.
+	FieldType   seq.TokenizerType
+	GroupByType seq.TokenizerType
+	// ThenBy are the fields grouped by together with GroupBy, buckets are the tuples of their values.
+	ThenBy []*parser.Literal
+	// ThenByTypes are index types of ThenBy fields.
+	ThenByTypes []seq.TokenizerType
+	// Buckets split numeric values of GroupBy into ranges or histogram bins.
+	Buckets seq.NumericBuckets
+	// Filters are counted instead of the groups if set, the documents matching each filter are in the bucket of its name.
+	Filters []AggFilter
+}
+
+// AggFilter is a named query of the filters aggregation.
+type AggFilter struct {
+	Name string
+	AST  *parser.ASTNode
+}
+
+// SortQuery is a field which indexed values order the found documents instead of their time.
+type SortQuery struct {
+	Field *parser.Literal
+	// FieldType is an index type of the field.
+	FieldType seq.TokenizerType
+	Desc      bool
 }
 
 type SearchParams struct {
@@ -25,6 +55,8 @@ type SearchParams struct {
 
 	WithTotal bool
 	Order     seq.DocsOrder
+	// Sort is set to order documents by field values, then Order is used for documents with the same values.
+	Sort []SortQuery
 }
 
 func (p *SearchParams) HasHist() bool {
@@ -35,6 +67,23 @@ func (p *SearchParams) HasAgg() bool {
 	return len(p.AggQ) > 0
 }
 
+func (p *SearchParams) HasSort() bool {
+	return len(p.Sort) > 0
+}
+
+// SortBy returns the sort fields to merge results of the search.
+func (p *SearchParams) SortBy() []seq.SortField {
+	if !p.HasSort() {
+		return nil
+	}
+	sortBy := make([]seq.SortField, len(p.Sort))
+	for i, s := range p.Sort {
+		sortBy[i] = seq.SortField{Field: s.Field.Field, Desc: s.Desc}
+	}
+	return sortBy
+}
+
 func (p *SearchParams) IsScanAllRequest() bool {
-	return p.WithTotal || p.HasAgg() || p.HasHist()
+	// Any found document can be in the top when sorting by fields, so all of them are scanned.
+	return p.WithTotal || p.HasAgg() || p.HasHist() || p.HasSort()
 }
@@ -0,0 +1,91 @@
+package processor
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ozontech/seq-db/metric/stopwatch"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/util"
+)
+
+// docsSorter collects the first documents ordered by values of the sort fields.
+type docsSorter struct {
+	fields []*SourcedNodeIterator
+	ti     tokenIndex
+	sortBy []seq.SortField
+	order  seq.DocsOrder
+	limit  int
+
+	ids seq.IDSources
+	// trimmed is true if ids were cut to limit at least once,
+	// then the last of the first limit ids is the threshold for the next documents.
+	trimmed bool
+	values  []seq.SortValue
+}
+
+func newDocsSorter(
+	ti tokenIndex, params SearchParams, sw *stopwatch.Stopwatch,
+	stats *searchStats, minLID, maxLID uint32,
+) (*docsSorter, error) {
+	s := &docsSorter{
+		ti:     ti,
+		sortBy: params.SortBy(),
+		order:  params.Order,
+		limit:  params.Limit,
+		values: make([]seq.SortValue, len(params.Sort)),
+	}
+	for _, q := range params.Sort {
+		// Sort field can have a token for each document, so there are no limits of unique values.
+		it, err := iteratorFromLiteral(ti, q.Field, q.FieldType, sw, stats, minLID, maxLID, 0, 0, params.Order)
+		if err != nil {
+			return nil, err
+		}
+		s.fields = append(s.fields, it)
+	}
+	return s, nil
+}
+
+// Next adds the document to the collected ones unless it is certainly out of the first limit documents.
+func (s *docsSorter) Next(lid uint32, idsIndex idsIndex) error {
+	for i, it := range s.fields {
+		source, has, err := it.ConsumeTokenSource(lid)
+		if err != nil {
+			return err
+		}
+		if !has {
+			s.values[i] = seq.SortValue{Missing: true}
+			continue
+		}
+		// Value is copied below only if the document is collected.
+		s.values[i] = seq.SortValue{Value: util.ByteToStringUnsafe(s.ti.GetValByTID(it.tids[source]))}
+	}
+
+	if s.trimmed && seq.CompareSortValues(s.values, s.ids[s.limit-1].SortValues, s.sortBy) > 0 {
+		return nil
+	}
+
+	values := make([]seq.SortValue, len(s.values))
+	for i, v := range s.values {
+		values[i] = seq.SortValue{Value: strings.Clone(v.Value), Missing: v.Missing}
+	}
+	id := seq.ID{MID: idsIndex.GetMID(seq.LID(lid)), RID: idsIndex.GetRID(seq.LID(lid))}
+	s.ids = append(s.ids, seq.IDSource{ID: id, SortValues: values})
+
+	if len(s.ids) >= 2*s.limit {
+		s.trim()
+	}
+	return nil
+}
+
+func (s *docsSorter) trim() {
+	slices.SortFunc(s.ids, func(a, b seq.IDSource) int { return seq.CompareSorted(a, b, s.sortBy, s.order) })
+	s.ids = s.ids[:min(len(s.ids), s.limit)]
+	s.trimmed = len(s.ids) == s.limit
+}
+
+// IDs returns the first limit documents in order.
+func (s *docsSorter) IDs() seq.IDSources {
+	s.trim()
+	return s.ids
+}
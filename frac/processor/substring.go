@@ -0,0 +1,61 @@
+package processor
+
+import (
+	"bytes"
+
+	"github.com/ozontech/seq-db/metric/stopwatch"
+	"github.com/ozontech/seq-db/node"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// evalSubstring returns Node that generates LIDs of documents containing n-grams of the substring.
+// If the substring is longer than n-grams, the documents are verified to contain it.
+func evalSubstring(
+	index searchIndex, token *parser.Substring, sw *stopwatch.Stopwatch, budget *verifyBudget,
+	stats *searchStats, minLID, maxLID uint32, order seq.DocsOrder,
+) (node.Node, error) {
+	root, err := evalTermsConjunction(index, seq.NgramField(token.Field), token.Grams, false, sw, stats, minLID, maxLID, order)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Verify {
+		return root, nil
+	}
+
+	value := []byte(token.Value)
+	return newVerifyNode(root, index, sw, budget, token.Source, token.CaseSensitive, func(fieldValue []byte) bool {
+		return bytes.Contains(fieldValue, value)
+	}), nil
+}
+
+// evalTermsConjunction returns Node that generates LIDs of documents containing all the terms in the field.
+// If foldCase is set, the terms are matched ignoring case.
+func evalTermsConjunction(
+	index searchIndex, field string, terms []string, foldCase bool, sw *stopwatch.Stopwatch,
+	stats *searchStats, minLID, maxLID uint32, order seq.DocsOrder,
+) (node.Node, error) {
+	var root node.Node
+	for _, term := range terms {
+		literal := &parser.Literal{
+			Field: field,
+			Terms: []parser.Term{{Kind: parser.TermText, Data: term}},
+		}
+		var token parser.Token = literal
+		if foldCase {
+			token = literal.IgnoreCaseRegexp()
+		}
+		leaf, err := evalLeaf(index, token, sw, stats, minLID, maxLID, order)
+		if err != nil {
+			return nil, err
+		}
+		if root == nil {
+			root = leaf
+			continue
+		}
+		stats.NodesTotal++
+		root = node.NewAnd(root, leaf, order.IsReverse())
+	}
+	return root, nil
+}
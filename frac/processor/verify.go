@@ -0,0 +1,197 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	insaneJSON "github.com/ozontech/insane-json"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/metric/stopwatch"
+	"github.com/ozontech/seq-db/node"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/util"
+)
+
+// verifyBatchSize is the number of candidate documents that are read at once during verification.
+const verifyBatchSize = 256
+
+// verifyBudget limits the number of documents read by all verify nodes of a single fraction search.
+// Verification can read many documents inside a single Next() call, so it checks the context as well.
+type verifyBudget struct {
+	ctx context.Context
+	// limit is the maximum number of verified documents, zero means no limit.
+	limit int
+	used  int
+}
+
+func (b *verifyBudget) spend(docs int) error {
+	if util.IsCancelled(b.ctx) {
+		return b.ctx.Err()
+	}
+	b.used += docs
+	if b.limit > 0 && b.used > b.limit {
+		return fmt.Errorf("%w: limit is %d", consts.ErrTooManyVerifiedDocs, b.limit)
+	}
+	return nil
+}
+
+// verifyNode filters out documents which field values don't match.
+// It is used when the index can only narrow down candidates, e.g. for substring and phrase filters.
+// Candidates are read in batches to group reads of the same docs block.
+type verifyNode struct {
+	source node.Node
+	index  docsIndex
+	sw     *stopwatch.Stopwatch
+	budget *verifyBudget
+
+	path          string
+	caseSensitive bool
+	match         func(value []byte) bool
+
+	decoder    *insaneJSON.Root
+	candidates []seq.LID
+	docs       [][]byte
+	verified   []uint32
+	pos        int
+
+	// err is the error of documents reading, it is checked after the tree evaluation.
+	err error
+}
+
+func newVerifyNode(
+	source node.Node, index docsIndex, sw *stopwatch.Stopwatch, budget *verifyBudget,
+	path string, caseSensitive bool, match func(value []byte) bool,
+) *verifyNode {
+	return &verifyNode{
+		source:        source,
+		index:         index,
+		sw:            sw,
+		budget:        budget,
+		path:          path,
+		caseSensitive: caseSensitive,
+		match:         match,
+	}
+}
+
+func (n *verifyNode) String() string {
+	return fmt.Sprintf("VERIFY(%s, %s)", n.source.String(), n.path)
+}
+
+func (n *verifyNode) Next() (uint32, bool) {
+	for n.pos == len(n.verified) {
+		if !n.verifyNext() {
+			return 0, false
+		}
+	}
+	lid := n.verified[n.pos]
+	n.pos++
+	return lid, true
+}
+
+// verifyNext verifies the next batch of candidates. It returns false if there are no more candidates.
+func (n *verifyNode) verifyNext() bool {
+	if n.err != nil {
+		return false
+	}
+
+	n.candidates = n.candidates[:0]
+	for len(n.candidates) < verifyBatchSize {
+		lid, has := n.source.Next()
+		if !has {
+			break
+		}
+		n.candidates = append(n.candidates, seq.LID(lid))
+	}
+	if len(n.candidates) == 0 {
+		return false
+	}
+
+	if err := n.budget.spend(len(n.candidates)); err != nil {
+		n.err = err
+		return false
+	}
+
+	m := n.sw.Start("verify_docs")
+	defer m.Stop()
+
+	n.docs = append(n.docs[:0], make([][]byte, len(n.candidates))...)
+	if err := readDocs(n.index, n.index.GetDocPosByLIDs(n.candidates), n.docs); err != nil {
+		n.err = err
+		return false
+	}
+
+	if n.decoder == nil {
+		n.decoder = insaneJSON.Spawn()
+	}
+
+	n.verified = n.verified[:0]
+	n.pos = 0
+	for i, doc := range n.docs {
+		if doc == nil || n.decoder.DecodeBytes(doc) != nil {
+			continue
+		}
+		if n.contains(n.decoder.Node, n.path) {
+			n.verified = append(n.verified, uint32(n.candidates[i]))
+		}
+	}
+	return true
+}
+
+// contains checks values of the field with the given path the same way as the indexer finds them:
+// path can be split by objects, arrays of nested objects and tags.
+func (n *verifyNode) contains(obj *insaneJSON.Node, path string) bool {
+	for _, field := range obj.AsFields() {
+		key := field.AsString()
+		value := field.AsFieldValue()
+
+		if key == path {
+			if n.valueContains(value) {
+				return true
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(path, key) || len(path) <= len(key) || path[len(key)] != seq.PathDelim[0] {
+			continue
+		}
+		rest := path[len(key)+1:]
+
+		if value.IsObject() && n.contains(value, rest) {
+			return true
+		}
+		if !value.IsArray() {
+			continue
+		}
+		for _, elem := range value.AsArray() {
+			if !elem.IsObject() {
+				continue
+			}
+			if n.contains(elem, rest) {
+				return true
+			}
+			if tagKey := elem.Dig("key"); tagKey != nil && tagKey.AsString() == rest && n.valueContains(elem.Dig("value")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n *verifyNode) valueContains(value *insaneJSON.Node) bool {
+	if value == nil {
+		return false
+	}
+	var data []byte
+	if value.IsArray() || value.IsObject() || value.IsNull() || value.IsTrue() || value.IsFalse() {
+		data = value.Encode(nil)
+	} else {
+		data = value.AsBytes()
+	}
+	if !n.caseSensitive {
+		data = bytes.ToLower(data)
+	}
+	return n.match(data)
+}
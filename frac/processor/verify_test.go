@@ -0,0 +1,75 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/metric/stopwatch"
+	"github.com/ozontech/seq-db/node"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// staticDocsIndex keeps all documents in a single block, LID is the offset of the document.
+type staticDocsIndex struct {
+	docs [][]byte
+}
+
+func (s *staticDocsIndex) GetBlocksOffsets(uint32) uint64 { return 0 }
+
+func (s *staticDocsIndex) ReadDocs(_ uint64, offsets []uint64) ([][]byte, error) {
+	res := make([][]byte, 0, len(offsets))
+	for _, offset := range offsets {
+		res = append(res, s.docs[offset])
+	}
+	return res, nil
+}
+
+func (s *staticDocsIndex) GetDocPosByLIDs(lids []seq.LID) []seq.DocPos {
+	res := make([]seq.DocPos, 0, len(lids))
+	for _, lid := range lids {
+		res = append(res, seq.PackDocPos(0, uint64(lid)))
+	}
+	return res
+}
+
+func newTestVerifyNode(index *staticDocsIndex, budget *verifyBudget) *verifyNode {
+	lids := make([]uint32, 0, len(index.docs))
+	for i := range index.docs {
+		lids = append(lids, uint32(i))
+	}
+	return newVerifyNode(node.NewStatic(lids, false), index, stopwatch.New(), budget, "message", false, func(value []byte) bool {
+		return bytes.Contains(value, []byte("needle"))
+	})
+}
+
+func TestVerifyNode(t *testing.T) {
+	index := &staticDocsIndex{}
+	for i := 0; i < 1000; i++ {
+		doc := `{"message":"hay"}`
+		if i%100 == 0 {
+			doc = `{"message":"hay NEEDLE hay"}`
+		}
+		index.docs = append(index.docs, []byte(doc))
+	}
+
+	n := newTestVerifyNode(index, &verifyBudget{ctx: context.Background()})
+	assert.Equal(t, []uint32{0, 100, 200, 300, 400, 500, 600, 700, 800, 900}, readAll(n))
+	require.NoError(t, n.err)
+
+	// Budget is exceeded before all candidates are read.
+	n = newTestVerifyNode(index, &verifyBudget{ctx: context.Background(), limit: 300})
+	assert.Equal(t, []uint32{0, 100, 200}, readAll(n))
+	require.ErrorIs(t, n.err, consts.ErrTooManyVerifiedDocs)
+
+	// Cancelled search stops verification.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n = newTestVerifyNode(index, &verifyBudget{ctx: ctx})
+	assert.Empty(t, readAll(n))
+	require.ErrorIs(t, n.err, context.Canceled)
+}
@@ -56,6 +56,26 @@ func (t Table) SelectEntries(field, hint string) []*TableEntry {
 	return data.Entries[l:r]
 }
 
+// SelectRangeEntries returns monotonic and continuous sequence of token table entries
+// that may contain tokens from the inclusive range [from, to]
+func (t Table) SelectRangeEntries(field, from, to string) []*TableEntry {
+	data, ok := t[field]
+	if !ok {
+		return nil
+	}
+
+	l := sort.Search(len(data.Entries), func(i int) bool {
+		return from <= data.Entries[i].MaxVal
+	})
+
+	// block containing the upper border is included too
+	r := l + sort.Search(len(data.Entries)-l, func(i int) bool {
+		return to <= data.Entries[l+i].MaxVal
+	})
+
+	return data.Entries[l:min(r+1, len(data.Entries))]
+}
+
 func (t Table) GetEntryByTID(tid uint32) *TableEntry {
 	if tid == 0 {
 		return nil
@@ -48,3 +48,27 @@ func TestSelectEntries(t *testing.T) {
 	assert.Equal(t, emptyEntries, table.SelectEntries("triple", "aaa"))
 	assert.Equal(t, emptyEntries, table.SelectEntries("triple", "xyz"))
 }
+
+func TestSelectRangeEntries(t *testing.T) {
+	triple := &FieldData{
+		MinVal: "ab",
+		Entries: []*TableEntry{
+			{MaxVal: "bb"},
+			{MaxVal: "bd"},
+			{MaxVal: "cd"},
+		},
+	}
+	table := Table{
+		"triple": triple,
+	}
+	emptyEntries := []*TableEntry{}
+
+	assert.Nil(t, table.SelectRangeEntries("unknown", "a", "z"))
+	assert.Equal(t, triple.Entries, table.SelectRangeEntries("triple", "a", "z"))
+	assert.Equal(t, triple.Entries[:1], table.SelectRangeEntries("triple", "a", "bb"))
+	assert.Equal(t, triple.Entries[:2], table.SelectRangeEntries("triple", "a", "bc"))
+	assert.Equal(t, triple.Entries[1:2], table.SelectRangeEntries("triple", "bc", "bd"))
+	assert.Equal(t, triple.Entries[1:3], table.SelectRangeEntries("triple", "bc", "c"))
+	assert.Equal(t, triple.Entries[2:3], table.SelectRangeEntries("triple", "cd", "z"))
+	assert.Equal(t, emptyEntries, table.SelectRangeEntries("triple", "da", "z"))
+}
@@ -93,6 +93,7 @@ func (dp *sealedDataProvider) getTokenIndex() *sealedTokenIndex {
 		lidsTable:        dp.lidsTable,
 		tokenTableLoader: dp.tokenTableLoader,
 		tokenBlockLoader: dp.tokenBlockLoader,
+		limits:           pattern.Limits{MaxRegexpTokens: dp.config.Search.MaxRegexpTokens},
 	}
 }
 
@@ -100,6 +101,7 @@ func (dp *sealedDataProvider) getSearchIndex() *sealedSearchIndex {
 	return &sealedSearchIndex{
 		sealedIDsIndex:   dp.getIDsIndex(),
 		sealedTokenIndex: dp.getTokenIndex(),
+		sealedFetchIndex: dp.getFetchIndex(),
 	}
 }
 
@@ -119,13 +121,14 @@ func (dp *sealedDataProvider) Fetch(ids []seq.ID) ([][]byte, error) {
 }
 
 func (dp *sealedDataProvider) Search(params processor.SearchParams) (*seq.QPR, error) {
+	params = adaptSearchParams(params, dp.info)
 	aggLimits := processor.AggLimits(dp.config.Search.AggLimits)
 
 	sw := stopwatch.New()
 	defer sw.Export(getSealedSearchMetric(params))
 
 	t := sw.Start("total")
-	qpr, err := processor.IndexSearch(dp.ctx, params, dp.getSearchIndex(), aggLimits, sw)
+	qpr, err := processor.IndexSearch(dp.ctx, params, dp.getSearchIndex(), aggLimits, dp.config.Search.MaxVerifiedDocs, sw)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +219,7 @@ type sealedTokenIndex struct {
 	lidsLoader       *lids.Loader
 	tokenTableLoader *token.TableLoader
 	tokenBlockLoader *token.BlockLoader
+	limits           pattern.Limits
 }
 
 func (ti *sealedTokenIndex) GetValByTID(tid uint32) []byte {
@@ -232,16 +236,26 @@ func (ti *sealedTokenIndex) GetTIDsByTokenExpr(t parser.Token) ([]uint32, error)
 	searchStr := parser.GetHint(t)
 
 	tokenTable := ti.tokenTableLoader.Load()
-	entries := tokenTable.SelectEntries(field, searchStr)
+
+	var entries []*token.TableEntry
+	if pattern.IsBinaryRange(t) {
+		from, to, ok := pattern.BinaryRangeBorders(t)
+		if !ok {
+			return nil, nil
+		}
+		entries = tokenTable.SelectRangeEntries(field, string(from), string(to))
+	} else {
+		entries = tokenTable.SelectEntries(field, searchStr)
+	}
 	if len(entries) == 0 {
 		return nil, nil
 	}
 
 	tp := token.NewProvider(ti.tokenBlockLoader, entries)
 
-	tids, err := pattern.Search(ti.ctx, t, tp)
+	tids, err := pattern.Search(ti.ctx, t, tp, ti.limits)
 	if err != nil {
-		return nil, fmt.Errorf("search error: %s field: %s, query: %s", err, field, searchStr)
+		return nil, fmt.Errorf("search error: %w field: %s, query: %s", err, field, searchStr)
 	}
 	return tids, nil
 }
@@ -288,7 +302,7 @@ func (fi *sealedFetchIndex) GetBlocksOffsets(num uint32) uint64 {
 }
 
 func (fi *sealedFetchIndex) GetDocPos(ids []seq.ID) []seq.DocPos {
-	return fi.getDocPosByLIDs(fi.findLIDs(ids))
+	return fi.GetDocPosByLIDs(fi.findLIDs(ids))
 }
 
 func (fi *sealedFetchIndex) ReadDocs(blockOffset uint64, docOffsets []uint64) ([][]byte, error) {
@@ -328,7 +342,7 @@ func (fi *sealedFetchIndex) findLIDs(ids []seq.ID) []seq.LID {
 // GetDocPosByLIDs returns a slice of DocPos for the corresponding LIDs.
 // Passing sorted LIDs (asc or desc) will improve the performance of this method.
 // For LID with zero value will return DocPos with `DocPosNotFound` value
-func (fi *sealedFetchIndex) getDocPosByLIDs(localIDs []seq.LID) []seq.DocPos {
+func (fi *sealedFetchIndex) GetDocPosByLIDs(localIDs []seq.LID) []seq.DocPos {
 	res := make([]seq.DocPos, len(localIDs))
 	for i, lid := range localIDs {
 		if lid == 0 {
@@ -343,4 +357,5 @@ func (fi *sealedFetchIndex) getDocPosByLIDs(localIDs []seq.LID) []seq.DocPos {
 type sealedSearchIndex struct {
 	*sealedIDsIndex
 	*sealedTokenIndex
+	*sealedFetchIndex
 }
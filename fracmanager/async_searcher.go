@@ -67,7 +67,7 @@ var (
 )
 
 type MappingProvider interface {
-	GetMapping() seq.Mapping
+	GetMapping() *seq.Mapping
 }
 
 type AsyncSearcher struct {
@@ -166,7 +166,7 @@ type asyncSearchInfo struct {
 	infoSize *atomic.Int64
 }
 
-func newAsyncSearchInfo(r AsyncSearchRequest, list List) asyncSearchInfo {
+func newAsyncSearchInfo(r AsyncSearchRequest, list List, startedAt time.Time) asyncSearchInfo {
 	fracsToSearch := make([]fracSearchState, 0, len(list))
 	for _, f := range list {
 		fracsToSearch = append(fracsToSearch, fracSearchState{Name: f.Info().Name()})
@@ -180,7 +180,7 @@ func newAsyncSearchInfo(r AsyncSearchRequest, list List) asyncSearchInfo {
 		cancel:     cancel,
 		Request:    r,
 		Fractions:  fracsToSearch,
-		StartedAt:  time.Now(),
+		StartedAt:  startedAt,
 		merged:     &atomic.Bool{},
 		qprsSize:   &atomic.Int64{},
 		infoSize:   &atomic.Int64{},
@@ -230,13 +230,17 @@ func (as *AsyncSearcher) StartSearch(r AsyncSearchRequest, fracs List) error {
 		return fmt.Errorf("invalid id %q: %s", r.ID, err)
 	}
 
-	ast, err := parser.ParseSeqQL(r.Query, as.mp.GetMapping())
+	now := timeNow()
+	// Relative dates are resolved at the start time of the search, so they don't change after restarts.
+	ast, err := parser.ParseSeqQLAt(r.Query, as.mp.GetMapping(), now)
 	if err != nil {
 		return err
 	}
+	if len(ast.Subqueries) > 0 {
+		return fmt.Errorf("subqueries must be resolved by seq-proxy")
+	}
 	r.Params.AST = ast.Root
 
-	now := timeNow()
 	if r.Retention < minRetention {
 		return fmt.Errorf("retention time should be at least %s, got %s", minRetention, r.Retention)
 	}
@@ -246,7 +250,7 @@ func (as *AsyncSearcher) StartSearch(r AsyncSearchRequest, fracs List) error {
 		return fmt.Errorf("retention time should be less than %s, got %s", maxRetention, r.Retention)
 	}
 
-	if ok := as.saveSearchInfo(r, fracs); !ok {
+	if ok := as.saveSearchInfo(r, fracs, now); !ok {
 		// Request was saved previously, skip it
 		return nil
 	}
@@ -256,13 +260,13 @@ func (as *AsyncSearcher) StartSearch(r AsyncSearchRequest, fracs List) error {
 	return nil
 }
 
-func (as *AsyncSearcher) saveSearchInfo(r AsyncSearchRequest, fracs List) bool {
+func (as *AsyncSearcher) saveSearchInfo(r AsyncSearchRequest, fracs List, startedAt time.Time) bool {
 	as.requestsMu.Lock()
 	defer as.requestsMu.Unlock()
 	if _, ok := as.requests[r.ID]; ok {
 		return false
 	}
-	info := newAsyncSearchInfo(r, fracs)
+	info := newAsyncSearchInfo(r, fracs, startedAt)
 	as.storeSearchInfoLocked(r.ID, info)
 	return true
 }
@@ -344,7 +348,7 @@ func (as *AsyncSearcher) doSearch(id string, fracs List) {
 
 	// AST can be nil in case of restarts.
 	if info.Request.Params.AST == nil {
-		ast, err := parser.ParseSeqQL(info.Request.Query, as.mp.GetMapping())
+		ast, err := parser.ParseSeqQLAt(info.Request.Query, as.mp.GetMapping(), info.StartedAt)
 		if err != nil {
 			panic(fmt.Errorf("BUG: search query must be valid: %s", err))
 		}
@@ -575,7 +579,7 @@ func loadAsyncRequests(dataDir string) (map[string]asyncSearchInfo, error) {
 		if err != nil {
 			return err
 		}
-		info := newAsyncSearchInfo(AsyncSearchRequest{}, nil)
+		info := newAsyncSearchInfo(AsyncSearchRequest{}, nil, time.Time{})
 		if err := json.Unmarshal(b, &info); err != nil {
 			return fmt.Errorf("malformed async search info %q: %s", name, err)
 		}
@@ -729,7 +733,7 @@ func (as *AsyncSearcher) loadSearchResult(qprsPaths []string, limit int, order s
 		if len(tail) > 0 {
 			logger.Fatal("unexpected tail when unmarshaling binary QPR", zap.String("path", qprPath))
 		}
-		seq.MergeQPRs(&qpr, []*seq.QPR{&tmp}, limit, 1, order)
+		seq.MergeQPRs(&qpr, []*seq.QPR{&tmp}, limit, 1, order, nil)
 	}
 	return qpr, size
 }
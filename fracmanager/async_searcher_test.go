@@ -43,7 +43,7 @@ func TestAsyncSearcherMaintain(t *testing.T) {
 	cfg := AsyncSearcherConfig{
 		DataDir: t.TempDir(),
 	}
-	mp, err := mappingprovider.New("", mappingprovider.WithMapping(seq.Mapping{}))
+	mp, err := mappingprovider.New("", mappingprovider.WithMapping(&seq.Mapping{}))
 	r.NoError(err)
 
 	as := MustStartAsync(cfg, mp, nil)
@@ -26,6 +26,8 @@ type Config struct {
 	SealParams        frac.SealParams
 	SortCacheSize     uint64 // size for docs cache for active fraction
 	Fraction          frac.Config
+	// Mapping is used to record index types of fields in new fractions. Nothing is recorded if it is nil.
+	Mapping MappingProvider
 
 	OffloadingEnabled   bool
 	OffloadingForced    bool
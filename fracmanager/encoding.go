@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"slices"
 
 	"github.com/ozontech/seq-db/seq"
 	"github.com/ozontech/seq-db/util"
@@ -14,7 +13,13 @@ import (
 
 var be = binary.BigEndian
 
-const qprBinVersion = uint8(1)
+const (
+	// qprBinVersionSamples encodes raw samples of the aggregations instead of the quantile sketches.
+	qprBinVersionSamples = uint8(1)
+	// qprBinVersionSketch encodes the quantile sketches without the cardinality ones.
+	qprBinVersionSketch = uint8(2)
+	qprBinVersion       = uint8(3)
+)
 
 func marshalQPR(q *seq.QPR, dst []byte) []byte {
 	dst = append(dst, qprBinVersion)
@@ -40,7 +45,7 @@ func unmarshalQPR(dst *seq.QPR, src []byte, idsLimit int) (_ []byte, err error)
 
 	version := src[0]
 	src = src[1:]
-	if version != qprBinVersion {
+	if version != qprBinVersion && version != qprBinVersionSketch && version != qprBinVersionSamples {
 		return nil, fmt.Errorf("invalid QPR version %d; want %d", version, qprBinVersion)
 	}
 
@@ -69,7 +74,7 @@ func unmarshalQPR(dst *seq.QPR, src []byte, idsLimit int) (_ []byte, err error)
 		return nil, fmt.Errorf("can't unmarshal histogram: %s", err)
 	}
 
-	dst.Aggs, src, err = unmarshalAggs(dst.Aggs, src)
+	dst.Aggs, src, err = unmarshalAggs(dst.Aggs, src, version)
 	if err != nil {
 		return nil, fmt.Errorf("can't unmarshal aggs: %s", err)
 	}
@@ -349,7 +354,7 @@ func marshalAggs(dst []byte, aggs []seq.AggregatableSamples) []byte {
 	return dst
 }
 
-func unmarshalAggs(dst []seq.AggregatableSamples, src []byte) (_ []seq.AggregatableSamples, _ []byte, err error) {
+func unmarshalAggs(dst []seq.AggregatableSamples, src []byte, version uint8) (_ []seq.AggregatableSamples, _ []byte, err error) {
 	var header aggsBlockHeader
 	src, err = header.Unmarshal(src)
 	if err != nil {
@@ -376,7 +381,7 @@ func unmarshalAggs(dst []seq.AggregatableSamples, src []byte) (_ []seq.Aggregata
 
 	for i := 0; len(block) > 0; i++ {
 		agg := seq.AggregatableSamples{}
-		block, err = unmarshalAggregatableSamples(&agg, block)
+		block, err = unmarshalAggregatableSamples(&agg, block, version)
 		if err != nil {
 			return nil, nil, fmt.Errorf("invalid QPRHistogram at pos %d: %v", i, err)
 		}
@@ -400,7 +405,7 @@ func marshalAggregatableSamples(s seq.AggregatableSamples, dst []byte) []byte {
 	return dst
 }
 
-func unmarshalAggregatableSamples(q *seq.AggregatableSamples, src []byte) ([]byte, error) {
+func unmarshalAggregatableSamples(q *seq.AggregatableSamples, src []byte, version uint8) ([]byte, error) {
 	if len(src) < 16 {
 		return nil, fmt.Errorf("src too short to unmarshal QPRHistogram, want at least 16 bytes, got %d", len(src))
 	}
@@ -429,7 +434,7 @@ func unmarshalAggregatableSamples(q *seq.AggregatableSamples, src []byte) ([]byt
 		src = src[v:]
 
 		sample := &samples[i]
-		tail, err := unmarshalSamplesContainer(sample, src)
+		tail, err := unmarshalSamplesContainer(sample, src, version)
 		if err != nil {
 			return nil, err
 		}
@@ -455,14 +460,21 @@ func marshalSamplesContainer(h *seq.SamplesContainer, dst []byte) []byte {
 	dst = binary.AppendUvarint(dst, uint64(h.Total))
 	dst = binary.AppendUvarint(dst, uint64(h.NotExists))
 
-	dst = binary.AppendUvarint(dst, uint64(len(h.Samples)))
-	for _, v := range h.Samples {
-		dst = be.AppendUint64(dst, math.Float64bits(v))
+	if h.Sketch == nil {
+		dst = append(dst, 0)
+	} else {
+		dst = append(dst, 1)
+		dst = h.Sketch.Append(dst)
 	}
-	return dst
+
+	if h.Cardinality == nil {
+		return append(dst, 0)
+	}
+	dst = append(dst, 1)
+	return h.Cardinality.Append(dst)
 }
 
-func unmarshalSamplesContainer(s *seq.SamplesContainer, src []byte) ([]byte, error) {
+func unmarshalSamplesContainer(s *seq.SamplesContainer, src []byte, version uint8) ([]byte, error) {
 	if len(src) < 27 {
 		return src, fmt.Errorf("histogram size too low")
 	}
@@ -487,18 +499,45 @@ func unmarshalSamplesContainer(s *seq.SamplesContainer, src []byte) ([]byte, err
 	}
 	s.NotExists = int64(v)
 
-	samples, n := binary.Uvarint(src)
-	if n <= 0 {
-		return src, fmt.Errorf("malformed samples length: %d", n)
+	if version == qprBinVersionSamples {
+		samples, n := binary.Uvarint(src)
+		if n <= 0 {
+			return src, fmt.Errorf("malformed samples length: %d", n)
+		}
+		src = src[n:]
+		for i := 0; i < int(samples); i++ {
+			v := math.Float64frombits(be.Uint64(src))
+			s.InsertSampleNTimes(v, 1, seq.DefaultQuantileError)
+			src = src[8:]
+		}
+		return src, nil
 	}
-	src = src[n:]
-	s.Samples = slices.Grow(s.Samples[:0], int(samples))
-	for i := 0; i < int(samples); i++ {
-		v := math.Float64frombits(be.Uint64(src))
-		s.Samples = append(s.Samples, v)
-		src = src[8:]
+
+	if len(src) == 0 {
+		return src, fmt.Errorf("malformed sketch flag")
 	}
-	return src, nil
+	hasSketch := src[0] == 1
+	src = src[1:]
+	var err error
+	if hasSketch {
+		if s.Sketch, src, err = seq.UnmarshalQuantileSketch(src); err != nil {
+			return src, err
+		}
+	}
+
+	if version == qprBinVersionSketch {
+		return src, nil
+	}
+	if len(src) == 0 {
+		return src, fmt.Errorf("malformed cardinality flag")
+	}
+	hasCardinality := src[0] == 1
+	src = src[1:]
+	if !hasCardinality {
+		return src, nil
+	}
+	s.Cardinality, src, err = seq.UnmarshalCardinalitySketch(src)
+	return src, err
 }
 
 func marshalErrorSource(dst []byte, errSrcs []seq.ErrorSource) []byte {
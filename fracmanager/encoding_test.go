@@ -1,6 +1,7 @@
 package fracmanager
 
 import (
+	"encoding/binary"
 	"math"
 	"math/rand/v2"
 	"testing"
@@ -21,6 +22,12 @@ func TestQPRMarshalUnmarshal(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, len(tail))
 		require.EqualExportedValues(t, qpr, out)
+		for i, agg := range qpr.Aggs {
+			for bin, hist := range agg.SamplesByBin {
+				require.Equal(t, hist.Sketch, out.Aggs[i].SamplesByBin[bin].Sketch)
+				require.Equal(t, hist.Cardinality, out.Aggs[i].SamplesByBin[bin].Cardinality)
+			}
+		}
 	}
 
 	test(seq.QPR{Histogram: map[seq.MID]uint64{}})
@@ -52,7 +59,7 @@ func TestQPRMarshalUnmarshal(t *testing.T) {
 						Sum:       100,
 						Total:     1,
 						NotExists: 0,
-						Samples:   []float64{100},
+						Sketch:    newSketch(100),
 					},
 					{Token: "seq-db store", MID: seq.MID(1)}: {
 						Min:       3,
@@ -60,7 +67,7 @@ func TestQPRMarshalUnmarshal(t *testing.T) {
 						Sum:       794,
 						Total:     1,
 						NotExists: 7,
-						Samples:   []float64{324},
+						Sketch:    newSketch(324),
 					},
 					{Token: "seq-db store", MID: seq.MID(2)}: {
 						Min:       2,
@@ -68,7 +75,15 @@ func TestQPRMarshalUnmarshal(t *testing.T) {
 						Sum:       544,
 						Total:     2,
 						NotExists: 3,
-						Samples:   []float64{324},
+						Sketch:    newSketch(324),
+					},
+					{Token: "seq-db ingestor"}: {
+						Total:       3,
+						Cardinality: newCardinality("user1", "user2", "user1"),
+					},
+					// Values of multiple group fields.
+					{Token: seq.JoinGroupTokens([]string{"seq-db store", "error"})}: {
+						Total: 4,
 					},
 				},
 				NotExists: 5412,
@@ -92,6 +107,58 @@ func TestQPRMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestUnmarshalSamplesContainerV1(t *testing.T) {
+	src := be.AppendUint64(nil, math.Float64bits(1))
+	src = be.AppendUint64(src, math.Float64bits(3))
+	src = be.AppendUint64(src, math.Float64bits(6))
+	src = binary.AppendUvarint(src, 3)
+	src = binary.AppendUvarint(src, 0)
+	src = binary.AppendUvarint(src, 3)
+	for _, v := range []float64{1, 2, 3} {
+		src = be.AppendUint64(src, math.Float64bits(v))
+	}
+
+	var s seq.SamplesContainer
+	tail, err := unmarshalSamplesContainer(&s, src, qprBinVersionSamples)
+	require.NoError(t, err)
+	require.Empty(t, tail)
+	require.Equal(t, int64(3), s.Total)
+	require.Equal(t, newSketch(1, 2, 3), s.Sketch)
+}
+
+func TestUnmarshalSamplesContainerV2(t *testing.T) {
+	src := be.AppendUint64(nil, math.Float64bits(1))
+	src = be.AppendUint64(src, math.Float64bits(3))
+	src = be.AppendUint64(src, math.Float64bits(6))
+	src = binary.AppendUvarint(src, 3)
+	src = binary.AppendUvarint(src, 0)
+	src = append(src, 1)
+	src = newSketch(1, 2, 3).Append(src)
+
+	var s seq.SamplesContainer
+	tail, err := unmarshalSamplesContainer(&s, src, qprBinVersionSketch)
+	require.NoError(t, err)
+	require.Empty(t, tail)
+	require.Equal(t, newSketch(1, 2, 3), s.Sketch)
+	require.Nil(t, s.Cardinality)
+}
+
+func newCardinality(values ...string) *seq.CardinalitySketch {
+	s := seq.NewCardinalitySketch()
+	for _, v := range values {
+		s.Add(seq.HashValue(v))
+	}
+	return s
+}
+
+func newSketch(values ...float64) *seq.QuantileSketch {
+	s := seq.NewQuantileSketch(seq.DefaultQuantileError)
+	for _, v := range values {
+		s.Add(v, 1)
+	}
+	return s
+}
+
 func getRandomQPR(size int) seq.QPR {
 	curTime := time.Now()
 	getTime := func() time.Time {
@@ -6,7 +6,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/ozontech/seq-db/config"
 	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/seq"
 	"github.com/ozontech/seq-db/storage"
 	"github.com/ozontech/seq-db/storage/s3"
 )
@@ -23,10 +25,11 @@ type fractionProvider struct {
 	cacheProvider *CacheMaintainer
 	activeIndexer *frac.ActiveIndexer
 	readLimiter   *storage.ReadLimiter
+	mapping       MappingProvider
 }
 
 func newFractionProvider(
-	c *frac.Config, s3cli *s3.Client, cp *CacheMaintainer,
+	c *frac.Config, s3cli *s3.Client, cp *CacheMaintainer, mapping MappingProvider,
 	readerWorkers, indexWorkers int,
 ) *fractionProvider {
 	ai := frac.NewActiveIndexer(indexWorkers, indexWorkers)
@@ -38,11 +41,12 @@ func newFractionProvider(
 		cacheProvider: cp,
 		activeIndexer: ai,
 		readLimiter:   storage.NewReadLimiter(readerWorkers, storeBytesRead),
+		mapping:       mapping,
 	}
 }
 
 func (fp *fractionProvider) NewActive(name string) *frac.Active {
-	return frac.NewActive(
+	active := frac.NewActive(
 		name,
 		fp.activeIndexer,
 		fp.readLimiter,
@@ -50,6 +54,13 @@ func (fp *fractionProvider) NewActive(name string) *frac.Active {
 		fp.cacheProvider.CreateSortDocsCache(),
 		fp.config,
 	)
+	if fp.mapping != nil {
+		mapping := fp.mapping.GetMapping()
+		active.SetBinaryFields(seq.BinaryFields(mapping))
+		active.SetCaseSensitiveFields(seq.CaseSensitiveFields(mapping, config.CaseSensitive))
+		active.SetTemplates(frac.NewFieldTemplates(mapping, config.CaseSensitive))
+	}
+	return active
 }
 
 func (fp *fractionProvider) NewSealed(name string, cachedInfo *frac.Info) *frac.Sealed {
@@ -74,10 +74,13 @@ func (s *Searcher) SearchDocs(ctx context.Context, fracs []frac.Fraction, params
 			return nil, err
 		}
 
-		seq.MergeQPRs(total, subQPRs, origLimit, seq.MID(params.HistInterval), params.Order)
+		seq.MergeQPRs(total, subQPRs, origLimit, seq.MID(params.HistInterval), params.Order, params.SortBy())
 
-		// reduce the limit on the number of ensured docs in response
-		params.Limit = origLimit - calcEnsuredIDsCount(total.IDs, remainingFracs, params.Order)
+		// reduce the limit on the number of ensured docs in response,
+		// docs sorted by field values can be displaced by docs of any fraction
+		if !params.HasSort() {
+			params.Limit = origLimit - calcEnsuredIDsCount(total.IDs, remainingFracs, params.Order)
+		}
 
 		subSearchesCnt++
 	}
@@ -23,7 +23,7 @@ func WithUpdatePeriod(up time.Duration) Option {
 	}
 }
 
-func WithMapping(m seq.Mapping) Option {
+func WithMapping(m *seq.Mapping) Option {
 	return func(p *MappingProvider) {
 		p.mapping = m
 		p.rawMapping = seq.NewRawMapping(m)
@@ -40,7 +40,7 @@ type MappingProvider struct {
 	filePath       string
 	updatePeriod   time.Duration
 	checksum       [sha256.Size]byte
-	mapping        seq.Mapping
+	mapping        *seq.Mapping
 	rawMapping     *seq.RawMapping
 	mu             sync.RWMutex
 	indexAllFields bool
@@ -74,7 +74,7 @@ func New(
 	return p, nil
 }
 
-func (p *MappingProvider) GetMapping() seq.Mapping {
+func (p *MappingProvider) GetMapping() *seq.Mapping {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
@@ -155,7 +155,7 @@ func (p *MappingProvider) reloadMapping() {
 	logger.Info("mapping updated", zap.String("file", p.filePath))
 }
 
-func (p *MappingProvider) updateMapping(m seq.Mapping) {
+func (p *MappingProvider) updateMapping(m *seq.Mapping) {
 	newRawMapping := seq.NewRawMapping(m)
 
 	p.mu.Lock()
@@ -35,7 +35,7 @@ func TestMappingProvider(t *testing.T) {
 	mappingProvider, err := New(mappingFilePath, WithUpdatePeriod(100*time.Millisecond))
 	require.NoError(t, err)
 
-	assert.Equal(t, seq.Mapping{"k8s_pod": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0)}, mappingProvider.GetMapping())
+	assert.Equal(t, &seq.Mapping{Fields: map[string]seq.MappingTypes{"k8s_pod": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0)}}, mappingProvider.GetMapping())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -46,9 +46,9 @@ func TestMappingProvider(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.EventuallyWithT(t, func(c *assert.CollectT) {
-		assert.Equal(c, seq.Mapping{
+		assert.Equal(c, &seq.Mapping{Fields: map[string]seq.MappingTypes{
 			"k8s_pod":  seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 			"trace_id": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-		}, mappingProvider.GetMapping())
+		}}, mappingProvider.GetMapping())
 	}, 5*time.Second, 500*time.Millisecond)
 }
@@ -277,6 +277,11 @@ var (
 	SkippedIndexesText    = skippedIndexes.WithLabelValues("text")
 	SkippedIndexesKeyword = skippedIndexes.WithLabelValues("keyword")
 	SkippedIndexesPath    = skippedIndexes.WithLabelValues("path")
+	SkippedIndexesLong    = skippedIndexes.WithLabelValues("long")
+	SkippedIndexesDouble  = skippedIndexes.WithLabelValues("double")
+	SkippedIndexesIP      = skippedIndexes.WithLabelValues("ip")
+	SkippedIndexesDate    = skippedIndexes.WithLabelValues("date")
+	SkippedIndexesNgram   = skippedIndexes.WithLabelValues("ngram")
 
 	skippedIndexesBytes = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "seq_db_store",
@@ -286,6 +291,7 @@ var (
 	SkippedIndexesBytesText    = skippedIndexesBytes.WithLabelValues("text")
 	SkippedIndexesBytesKeyword = skippedIndexesBytes.WithLabelValues("keyword")
 	SkippedIndexesBytesPath    = skippedIndexesBytes.WithLabelValues("path")
+	SkippedIndexesBytesNgram   = skippedIndexesBytes.WithLabelValues("ngram")
 
 	OffloadingTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "seq_db_store",
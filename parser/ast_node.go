@@ -112,6 +112,14 @@ func (e *ASTNode) Dump(builder *strings.Builder) {
 		t.Dump(builder)
 	case *Range:
 		t.Dump(builder)
+	case *Substring:
+		t.Dump(builder)
+	case *Phrase:
+		t.Dump(builder)
+	case *Regexp:
+		t.Dump(builder)
+	case *Subquery:
+		t.Dump(builder)
 	default:
 		panic("unknown token implementation")
 	}
@@ -153,6 +161,14 @@ func (e *ASTNode) DumpSeqQL(b *strings.Builder) {
 		t.DumpSeqQL(b)
 	case *IPRange:
 		t.DumpSeqQL(b)
+	case *Substring:
+		t.DumpSeqQL(b)
+	case *Phrase:
+		t.DumpSeqQL(b)
+	case *Regexp:
+		t.DumpSeqQL(b)
+	case *Subquery:
+		t.DumpSeqQL(b)
 	default:
 		panic(fmt.Errorf("unknown token implementation: %T", e.Value))
 	}
@@ -3,13 +3,16 @@ package parser
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/ozontech/seq-db/analyzer"
+	"github.com/ozontech/seq-db/config"
 	"github.com/ozontech/seq-db/seq"
 )
 
 type queryParser struct {
 	tokenParser
-	mapping seq.Mapping
+	mapping *seq.Mapping
 }
 
 var builtinMapping = map[string]seq.TokenizerType{
@@ -18,11 +21,11 @@ var builtinMapping = map[string]seq.TokenizerType{
 	seq.TokenIndex:  seq.TokenizerTypeKeyword,
 }
 
-func indexType(userMapping seq.Mapping, field string) seq.TokenizerType {
+func indexType(userMapping *seq.Mapping, field string) seq.TokenizerType {
 	if userMapping == nil {
 		return seq.TokenizerTypeKeyword
 	}
-	tokKinds, has := userMapping[field]
+	tokKinds, has := userMapping.Lookup(field)
 	if has {
 		return tokKinds.Main.TokenizerType
 	}
@@ -33,6 +36,49 @@ func indexType(userMapping seq.Mapping, field string) seq.TokenizerType {
 	return seq.TokenizerTypeNoop
 }
 
+// fieldAnalyzer returns analyzer of the text field or nil if it has none.
+func fieldAnalyzer(userMapping *seq.Mapping, field string) *analyzer.Analyzer {
+	if userMapping == nil {
+		return nil
+	}
+	types, _ := userMapping.Lookup(field)
+	return types.Main.Analyzer
+}
+
+// fieldCaseSensitive returns false if values of the field are indexed in lower case,
+// so the searched values must be lowercased too.
+func fieldCaseSensitive(userMapping *seq.Mapping, field string) bool {
+	if field == seq.TokenExists || seq.IsNumericType(indexType(userMapping, field)) {
+		// Numbers and dates are parsed from the original value.
+		return true
+	}
+	if userMapping == nil {
+		return config.CaseSensitive
+	}
+	types, _ := userMapping.Lookup(field)
+	return types.Main.IsCaseSensitive(config.CaseSensitive)
+}
+
+// sourceField returns the document field which is indexed as the given field.
+// It differs from the field for additional indexes of the field, e.g. it is "message" for "message.keyword".
+func sourceField(mapping *seq.Mapping, field string) string {
+	if mapping == nil {
+		return field
+	}
+	for parent := field; ; {
+		i := strings.LastIndex(parent, seq.PathDelim)
+		if i < 0 {
+			return field
+		}
+		parent = parent[:i]
+		for _, t := range mapping.Fields[parent].All {
+			if t.Title == field {
+				return parent
+			}
+		}
+	}
+}
+
 // parseSubexpr parses subexpression, delimited by AND, OR, NOT or enclosing round bracket
 // i.e. either `token`, `NOT subexpr` or `(expr)`
 func (qp *queryParser) parseSubexpr(depth int) (*ASTNode, error) {
@@ -73,10 +119,16 @@ func (qp *queryParser) parseSubexpr(depth int) (*ASTNode, error) {
 		qp.pos = pos
 		return nil, qp.errorWrap(fmt.Errorf(`unindexed field "%s"`, fieldName))
 	}
-	tokens, err := qp.parseTokenQuery(fieldName, indexType)
+	tokens, err := qp.parseTokenQuery(fieldName, indexType, fieldCaseSensitive(qp.mapping, fieldName))
 	if err != nil {
 		return nil, err
 	}
+	if a := fieldAnalyzer(qp.mapping, fieldName); a != nil {
+		if tokens, err = analyzeLiterals(tokens, a); err != nil {
+			qp.pos = pos
+			return nil, qp.errorWrap(err)
+		}
+	}
 	return buildAndTree(tokens), nil
 }
 
@@ -134,7 +186,7 @@ func (qp *queryParser) parseExpr(depth int) (*ASTNode, error) {
 	}
 }
 
-func buildAst(data string, mapping seq.Mapping) (*ASTNode, error) {
+func buildAst(data string, mapping *seq.Mapping) (*ASTNode, error) {
 	p := queryParser{
 		tokenParser: tokenParser{
 			data: []rune(data),
@@ -145,11 +197,13 @@ func buildAst(data string, mapping seq.Mapping) (*ASTNode, error) {
 	return p.parseExpr(0)
 }
 
-func ParseQuery(data string, mapping seq.Mapping) (*ASTNode, error) {
+func ParseQuery(data string, mapping *seq.Mapping) (*ASTNode, error) {
 	root, err := buildAst(data, mapping)
 	if err != nil {
 		return nil, err
 	}
+	rewriteNgramFilters(root, mapping)
+	resolveRelativeDates(root, time.Now())
 	root, not := propagateNot(root)
 	if not {
 		return newNotNode(root), nil
@@ -165,7 +219,7 @@ func ParseSingleTokenForTests(name, data string) (Token, error) {
 	if p.eof() {
 		return nil, p.errorEOF("need literal")
 	}
-	tokens, err := p.parseLiteral(name, seq.TokenizerTypeKeyword)
+	tokens, err := p.parseLiteral(name, seq.TokenizerTypeKeyword, fieldCaseSensitive(nil, name))
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +241,7 @@ func ParseAggregationFilter(data string) (*Literal, error) {
 	if fieldName == "" {
 		return nil, p.errorUnexpectedSymbol("in place of field name")
 	}
-	tokens, err := p.parseTokenQuery(fieldName, seq.TokenizerTypeKeyword)
+	tokens, err := p.parseTokenQuery(fieldName, seq.TokenizerTypeKeyword, fieldCaseSensitive(nil, fieldName))
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -13,11 +14,25 @@ import (
 type SeqQLQuery struct {
 	Root  *ASTNode
 	Pipes []Pipe
+	// Subqueries are the subqueries of the filter, which are resolved by seq-proxy.
+	Subqueries []*Subquery
+	// Time is the range of document timestamps set by `_time` filters, nil if there are none.
+	// The filters are removed from Root.
+	Time *TimeRange
 }
 
 func (q *SeqQLQuery) SeqQLString() string {
 	b := &strings.Builder{}
-	q.Root.DumpSeqQL(b)
+	switch {
+	case q.Time == nil:
+		q.Root.DumpSeqQL(b)
+	case isMatchAll(q.Root):
+		q.Time.DumpSeqQL(b)
+	default:
+		q.Time.DumpSeqQL(b)
+		b.WriteString(" and ")
+		q.Root.DumpSeqQL(b)
+	}
 	for _, p := range q.Pipes {
 		b.WriteString(" | ")
 		p.DumpSeqQL(b)
@@ -25,7 +40,12 @@ func (q *SeqQLQuery) SeqQLString() string {
 	return b.String()
 }
 
-func ParseSeqQL(q string, mapping seq.Mapping) (SeqQLQuery, error) {
+func ParseSeqQL(q string, mapping *seq.Mapping) (SeqQLQuery, error) {
+	return ParseSeqQLAt(q, mapping, time.Now())
+}
+
+// ParseSeqQLAt parses seq-ql query resolving relative dates like "now-1h" against the given time.
+func ParseSeqQLAt(q string, mapping *seq.Mapping, now time.Time) (SeqQLQuery, error) {
 	lex := newLexer(q)
 
 	lex.Next()
@@ -46,14 +66,24 @@ func ParseSeqQL(q string, mapping seq.Mapping) (SeqQLQuery, error) {
 		panic(fmt.Errorf("BUG: lexer is not end: %+v", lex))
 	}
 
+	rewriteNgramFilters(root, mapping)
+	resolveRelativeDates(root, now)
+
+	root, timeRange, err := extractTimeRange(root)
+	if err != nil {
+		return SeqQLQuery{}, err
+	}
+
 	root, not := propagateNot(root)
 	if not {
 		root = newNotNode(root)
 	}
 
 	return SeqQLQuery{
-		Root:  root,
-		Pipes: pipes,
+		Root:       root,
+		Pipes:      pipes,
+		Subqueries: collectSubqueries(root, nil),
+		Time:       timeRange,
 	}, nil
 }
 
@@ -73,6 +103,9 @@ type lexer struct {
 	TokenQuoted bool
 	// rawString is true if current token is raw string (string quoted with `).
 	rawString bool
+	// tail is the length of the query tail starting at current token.
+	// It allows to find the position of the token in the query, since q is always suffix of the query.
+	tail int
 }
 
 func newLexer(q string) lexer {
@@ -145,6 +178,7 @@ again:
 	r, size := utf8.DecodeRuneInString(lex.q)
 	if r == utf8.RuneError {
 		// It is empty string or invalid UTF-8 sequence.
+		lex.tail = len(lex.q)
 		lex.nextToken(size)
 		return
 	}
@@ -167,6 +201,8 @@ again:
 		goto again
 	}
 
+	lex.tail = len(lex.q)
+
 	// Decode simple token.
 	tokenLen := 0
 	for isTokenRune(r) {
@@ -297,7 +333,7 @@ func (lex *lexer) nextToken(size int) {
 }
 
 // parseSeqQLFilter parses SeqQL full text search filters like `service:payment-api and level:"info"` and returns AST node.
-func parseSeqQLFilter(lex *lexer, mapping seq.Mapping, depth int) (*ASTNode, error) {
+func parseSeqQLFilter(lex *lexer, mapping *seq.Mapping, depth int) (*ASTNode, error) {
 	var res *ASTNode
 
 	cur, err := parseSeqQLSubexpr(lex, mapping, depth)
@@ -343,7 +379,7 @@ func joinOr(left, right *ASTNode) *ASTNode {
 	return newLogicalNode(LogicalOr, left, right)
 }
 
-func parseSeqQLSubexpr(lex *lexer, mapping seq.Mapping, depth int) (*ASTNode, error) {
+func parseSeqQLSubexpr(lex *lexer, mapping *seq.Mapping, depth int) (*ASTNode, error) {
 	if lex.IsEnd() {
 		return nil, fmt.Errorf("unexpected end of query")
 	}
@@ -351,12 +387,7 @@ func parseSeqQLSubexpr(lex *lexer, mapping seq.Mapping, depth int) (*ASTNode, er
 	if lex.IsKeyword(string(wildcardRune)) && depth == 0 {
 		lex.Next()
 		// Query is `*`.
-		return &ASTNode{
-			Value: &Literal{
-				Field: seq.TokenAll,
-				Terms: []Term{{Kind: TermSymbol, Data: "*"}},
-			},
-		}, nil
+		return newMatchAllNode(), nil
 	}
 
 	if lex.IsKeyword("(") {
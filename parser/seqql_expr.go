@@ -0,0 +1,480 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExprKind is a kind of expression node of the pipes which are evaluated on fetched documents.
+type ExprKind int
+
+const (
+	// ExprField is a value of the document field, Value is a field path like "payload.status".
+	ExprField ExprKind = iota
+	// ExprString is a string literal.
+	ExprString
+	// ExprNumber is a numeric literal, Value keeps its text and Number its parsed value.
+	ExprNumber
+	// ExprBool is a boolean literal.
+	ExprBool
+	// ExprNull is a null literal.
+	ExprNull
+
+	// ExprCompare compares two arguments with Op: =, !=, <, <=, > or >=.
+	ExprCompare
+	// ExprExists checks if the field of the single argument is present in the document.
+	ExprExists
+
+	// ExprArith applies arithmetic Op to two arguments: +, -, *, / or %.
+	ExprArith
+	// ExprNeg negates the single argument.
+	ExprNeg
+	// ExprCall calls function Value with the arguments, e.g. `lower(level)`.
+	ExprCall
+
+	ExprAnd
+	ExprOr
+	ExprNot
+)
+
+// Expr is a node of expression which is evaluated on fetched documents, e.g. condition of 'where' pipe.
+type Expr struct {
+	Kind   ExprKind
+	Op     string
+	Value  string
+	Number float64
+	Bool   bool
+	Args   []*Expr
+}
+
+var compareOps = []string{"=", "!=", "<", "<=", ">", ">="}
+
+// exprFuncs are the functions which can be called in expressions with their min and max number of arguments,
+// negative max means any number of arguments.
+var exprFuncs = map[string][2]int{
+	"lower":  {1, 1},
+	"upper":  {1, 1},
+	"substr": {2, 3},
+	"concat": {1, -1},
+	"if":     {3, 3},
+}
+
+// Precedence of the expressions, operators with higher precedence bind tighter.
+const (
+	precOr = iota + 1
+	precAnd
+	precNot
+	precCompare
+	precAdd
+	precMul
+	precNeg
+	precPrimary
+)
+
+func (e *Expr) precedence() int {
+	switch e.Kind {
+	case ExprOr:
+		return precOr
+	case ExprAnd:
+		return precAnd
+	case ExprNot:
+		return precNot
+	case ExprCompare:
+		return precCompare
+	case ExprArith:
+		if e.Op == "+" || e.Op == "-" {
+			return precAdd
+		}
+		return precMul
+	case ExprNeg:
+		return precNeg
+	}
+	return precPrimary
+}
+
+// DumpSeqQL writes the expression in seq-ql syntax.
+func (e *Expr) DumpSeqQL(o *strings.Builder) {
+	prec := e.precedence()
+	switch e.Kind {
+	case ExprField:
+		o.WriteString(quoteTokenIfNeeded(e.Value))
+	case ExprString:
+		o.WriteString(quote(e.Value))
+	case ExprNumber:
+		o.WriteString(e.Value)
+	case ExprBool:
+		o.WriteString(strconv.FormatBool(e.Bool))
+	case ExprNull:
+		o.WriteString("null")
+	case ExprCompare, ExprArith:
+		// Comparison is not associative, so operands of the same precedence need parentheses
+		// on both sides, arithmetic operators are left-associative.
+		dumpExprParens(o, e.Args[0], e.Args[0].precedence() < prec || e.Kind == ExprCompare && e.Args[0].precedence() == prec)
+		o.WriteString(" " + e.Op + " ")
+		dumpExprParens(o, e.Args[1], e.Args[1].precedence() <= prec)
+	case ExprNeg:
+		o.WriteString("-")
+		dumpExprParens(o, e.Args[0], e.Args[0].precedence() < prec)
+	case ExprExists, ExprCall:
+		name := e.Value
+		if e.Kind == ExprExists {
+			name = "exists"
+		}
+		o.WriteString(name + "(")
+		for i, arg := range e.Args {
+			if i > 0 {
+				o.WriteString(", ")
+			}
+			arg.DumpSeqQL(o)
+		}
+		o.WriteString(")")
+	case ExprAnd, ExprOr:
+		sep := " and "
+		if e.Kind == ExprOr {
+			sep = " or "
+		}
+		for i, arg := range e.Args {
+			if i > 0 {
+				o.WriteString(sep)
+			}
+			// 'and' binds tighter than 'or', so only 'or' inside of 'and' needs parentheses.
+			dumpExprParens(o, arg, arg.precedence() < prec)
+		}
+	case ExprNot:
+		o.WriteString("not ")
+		arg := e.Args[0]
+		dumpExprParens(o, arg, arg.precedence() < prec)
+	default:
+		panic(fmt.Errorf("BUG: unknown expression kind: %d", e.Kind))
+	}
+}
+
+func dumpExprParens(o *strings.Builder, e *Expr, parens bool) {
+	if parens {
+		o.WriteString("(")
+	}
+	e.DumpSeqQL(o)
+	if parens {
+		o.WriteString(")")
+	}
+}
+
+// isCondition returns true if the expression evaluates to boolean.
+func (e *Expr) isCondition() bool {
+	switch e.Kind {
+	case ExprCompare, ExprExists, ExprBool:
+		return true
+	case ExprAnd, ExprOr, ExprNot:
+		for _, arg := range e.Args {
+			if !arg.isCondition() {
+				return false
+			}
+		}
+		return true
+	case ExprCall:
+		return e.Value == "if" && e.Args[1].isCondition() && e.Args[2].isCondition()
+	}
+	return false
+}
+
+// parseCondition parses boolean expression like `payload.status = "FAILED" and latency_ms > 500`.
+func parseCondition(lex *lexer) (*Expr, error) {
+	cond, err := parseExpr(lex)
+	if err != nil {
+		return nil, err
+	}
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected 'and', 'or', got: %q", lex.Token)
+	}
+	if !cond.isCondition() {
+		o := &strings.Builder{}
+		cond.DumpSeqQL(o)
+		return nil, fmt.Errorf("expected condition, got: %q", o.String())
+	}
+	return cond, nil
+}
+
+// parseExpr parses expression like `latency_ms / 1000` or `lower(level) = "error" and latency_ms > 500`.
+// It stops on the first token which does not continue the expression, so caller must check it.
+func parseExpr(lex *lexer) (*Expr, error) {
+	left, err := parseExprAnd(lex)
+	if err != nil {
+		return nil, err
+	}
+	for lex.IsKeyword("or") {
+		lex.Next()
+		right, err := parseExprAnd(lex)
+		if err != nil {
+			return nil, err
+		}
+		left = joinExpr(ExprOr, left, right)
+	}
+	return left, nil
+}
+
+func parseExprAnd(lex *lexer) (*Expr, error) {
+	left, err := parseExprNot(lex)
+	if err != nil {
+		return nil, err
+	}
+	for lex.IsKeyword("and") {
+		lex.Next()
+		right, err := parseExprNot(lex)
+		if err != nil {
+			return nil, err
+		}
+		left = joinExpr(ExprAnd, left, right)
+	}
+	return left, nil
+}
+
+// joinExpr joins operands of the same logical operator to the single node.
+func joinExpr(kind ExprKind, left, right *Expr) *Expr {
+	if left.Kind == kind {
+		left.Args = append(left.Args, right)
+		return left
+	}
+	return &Expr{Kind: kind, Args: []*Expr{left, right}}
+}
+
+func parseExprNot(lex *lexer) (*Expr, error) {
+	if !lex.IsKeyword("not") {
+		return parseExprCompare(lex)
+	}
+	lex.Next()
+	arg, err := parseExprNot(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Kind: ExprNot, Args: []*Expr{arg}}, nil
+}
+
+func parseExprCompare(lex *lexer) (*Expr, error) {
+	left, err := parseExprAdd(lex)
+	if err != nil {
+		return nil, err
+	}
+	if !lex.IsKeywords("=", "!", "<", ">") {
+		return left, nil
+	}
+	op, err := parseCompareOp(lex)
+	if err != nil {
+		return nil, err
+	}
+	right, err := parseExprAdd(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Kind: ExprCompare, Op: op, Args: []*Expr{left, right}}, nil
+}
+
+// parseCompareOp parses comparison operator, two-symbol operators must not contain spaces.
+func parseCompareOp(lex *lexer) (string, error) {
+	if !lex.IsKeywords("=", "!", "<", ">") {
+		return "", fmt.Errorf("expected comparison operator, got: %q", lex.Token)
+	}
+	op := lex.Token
+	lex.Next()
+	if op != "=" && lex.IsKeyword("=") && !lex.SpaceSkipped {
+		op += "="
+		lex.Next()
+	}
+	if op == "!" {
+		return "", fmt.Errorf("expected '!=', got: '!'")
+	}
+	return op, nil
+}
+
+func parseExprAdd(lex *lexer) (*Expr, error) {
+	left, err := parseExprMul(lex)
+	if err != nil {
+		return nil, err
+	}
+	for lex.IsKeywords("+", "-") {
+		op := lex.Token
+		lex.Next()
+		right, err := parseExprMul(lex)
+		if err != nil {
+			return nil, err
+		}
+		left = &Expr{Kind: ExprArith, Op: op, Args: []*Expr{left, right}}
+	}
+	return left, nil
+}
+
+func parseExprMul(lex *lexer) (*Expr, error) {
+	left, err := parseExprNeg(lex)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op string
+		switch {
+		case lex.IsKeyword(string(wildcardRune)):
+			op = "*"
+		case lex.IsKeywords("/", "%"):
+			op = lex.Token
+		default:
+			return left, nil
+		}
+		lex.Next()
+		right, err := parseExprNeg(lex)
+		if err != nil {
+			return nil, err
+		}
+		left = &Expr{Kind: ExprArith, Op: op, Args: []*Expr{left, right}}
+	}
+}
+
+func parseExprNeg(lex *lexer) (*Expr, error) {
+	if !lex.IsKeyword("-") {
+		return parseExprPrimary(lex)
+	}
+	lex.Next()
+	arg, err := parseExprNeg(lex)
+	if err != nil {
+		return nil, err
+	}
+	if arg.Kind == ExprNumber {
+		// Negative numbers are literals.
+		arg.Number = -arg.Number
+		if s, ok := strings.CutPrefix(arg.Value, "-"); ok {
+			arg.Value = s
+		} else {
+			arg.Value = "-" + arg.Value
+		}
+		return arg, nil
+	}
+	return &Expr{Kind: ExprNeg, Args: []*Expr{arg}}, nil
+}
+
+// parseExprPrimary parses literal, field, function call or expression in parentheses.
+func parseExprPrimary(lex *lexer) (*Expr, error) {
+	if lex.TokenQuoted {
+		s := strings.ReplaceAll(lex.Token, string(wildcardRune), "*")
+		lex.Next()
+		return &Expr{Kind: ExprString, Value: s}, nil
+	}
+	switch {
+	case lex.IsEnd() || lex.IsKeyword("|"):
+		return nil, fmt.Errorf("unexpected end of expression")
+	case lex.IsKeyword("("):
+		lex.Next()
+		expr, err := parseExpr(lex)
+		if err != nil {
+			return nil, err
+		}
+		if !lex.IsKeyword(")") {
+			return nil, fmt.Errorf("missing ')'")
+		}
+		lex.Next()
+		return expr, nil
+	case lex.IsKeyword("true"), lex.IsKeyword("false"):
+		b := lex.IsKeyword("true")
+		lex.Next()
+		return &Expr{Kind: ExprBool, Bool: b}, nil
+	case lex.IsKeyword("null"):
+		lex.Next()
+		return &Expr{Kind: ExprNull}, nil
+	}
+
+	field, err := parseExprField(lex)
+	if err != nil {
+		return nil, err
+	}
+	if lex.IsKeyword("(") {
+		return parseExprCall(lex, strings.ToLower(field.Value))
+	}
+	if isDecimalNumber(field.Value) {
+		n, err := strconv.ParseFloat(field.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", field.Value, err)
+		}
+		return &Expr{Kind: ExprNumber, Value: field.Value, Number: n}, nil
+	}
+	return field, nil
+}
+
+// isDecimalNumber reports whether s is a plain decimal number like "12", "1.5" or "1e-3".
+// Other values accepted by strconv.ParseFloat like "inf", "nan" and "0x1p3" are field names.
+func isDecimalNumber(s string) bool {
+	i, digits := 0, 0
+	skipDigits := func() {
+		for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	skipDigits()
+	if i < len(s) && s[i] == '.' {
+		i++
+		skipDigits()
+	}
+	if digits == 0 {
+		return false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		digits = 0
+		skipDigits()
+		if digits == 0 {
+			return false
+		}
+	}
+	return i == len(s)
+}
+
+// parseExprCall parses arguments of the function, lexer must point to '('.
+func parseExprCall(lex *lexer, name string) (*Expr, error) {
+	lex.Next()
+	if name == "exists" {
+		field, err := parseExprField(lex)
+		if err != nil {
+			return nil, err
+		}
+		if !lex.IsKeyword(")") {
+			return nil, fmt.Errorf("missing ')' after 'exists' argument")
+		}
+		lex.Next()
+		return &Expr{Kind: ExprExists, Args: []*Expr{field}}, nil
+	}
+
+	arity, ok := exprFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function: %q", name)
+	}
+	call := &Expr{Kind: ExprCall, Value: name}
+	for !lex.IsKeyword(")") {
+		if len(call.Args) > 0 {
+			if !lex.IsKeyword(",") {
+				return nil, fmt.Errorf("expected ',' or ')' after %q argument, got: %q", name, lex.Token)
+			}
+			lex.Next()
+		}
+		arg, err := parseExpr(lex)
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+	}
+	lex.Next()
+
+	if n := len(call.Args); n < arity[0] || arity[1] >= 0 && n > arity[1] {
+		return nil, fmt.Errorf("wrong number of %q arguments: %d", name, n)
+	}
+	return call, nil
+}
+
+func parseExprField(lex *lexer) (*Expr, error) {
+	if lex.IsKeywords("|", "(", ")", ",") || lex.IsKeywords(compareOps...) {
+		return nil, fmt.Errorf("expected field name, got: %q", lex.Token)
+	}
+	field, err := parseFieldName(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{Kind: ExprField, Value: field}, nil
+}
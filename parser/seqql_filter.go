@@ -2,6 +2,7 @@ package parser
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -9,11 +10,12 @@ import (
 	"unicode"
 	"unicode/utf8"
 
-	"github.com/ozontech/seq-db/config"
+	"github.com/ozontech/seq-db/analyzer"
 	"github.com/ozontech/seq-db/seq"
 )
 
-func parseSeqQLFieldFilter(lex *lexer, mapping seq.Mapping) (*ASTNode, error) {
+func parseSeqQLFieldFilter(lex *lexer, mapping *seq.Mapping) (*ASTNode, error) {
+	start := lex.tail
 	fieldName, err := parseCompositeTokenReplaceWildcards(lex)
 	if err != nil {
 		return nil, fmt.Errorf("parsing field name: %s", err)
@@ -23,7 +25,7 @@ func parseSeqQLFieldFilter(lex *lexer, mapping seq.Mapping) (*ASTNode, error) {
 	}
 
 	t := indexType(mapping, fieldName)
-	if t == seq.TokenizerTypeNoop {
+	if t == seq.TokenizerTypeNoop && fieldName != timeField {
 		return nil, fmt.Errorf("field %q is not indexed", fieldName)
 	}
 
@@ -36,23 +38,33 @@ func parseSeqQLFieldFilter(lex *lexer, mapping seq.Mapping) (*ASTNode, error) {
 		return nil, fmt.Errorf("missing filter value for field %q", fieldName)
 	}
 
-	caseSensitive := config.CaseSensitive
-	if fieldName == seq.TokenExists {
-		caseSensitive = true
+	if fieldName == timeField {
+		return parseTimeFilter(lex)
 	}
 
+	caseSensitive := fieldCaseSensitive(mapping, fieldName)
+	a := fieldAnalyzer(mapping, fieldName)
+
 	// Parse range filter.
 	if lex.IsKeywords("[", "(") {
+		if t == seq.TokenizerTypeIP {
+			return nil, fmt.Errorf("range filter is not supported for ip field %q, use 'ip_range' or 'cidr' instead", fieldName)
+		}
 		r, err := parseSeqQLTokenRange(fieldName, lex, caseSensitive)
 		if err != nil {
 			return nil, fmt.Errorf("parsing range for field %q: %s", fieldName, err)
 		}
+		if seq.IsNumericType(t) {
+			if err := setNumericRange(r, t); err != nil {
+				return nil, fmt.Errorf("parsing range for field %q: %s", fieldName, err)
+			}
+		}
 		return &ASTNode{Value: r}, nil
 	}
 
 	if lex.IsKeyword("ip_range") {
-		if t != seq.TokenizerTypeKeyword {
-			return nil, fmt.Errorf("'ip_range' filter is supported only for keyword fields")
+		if t != seq.TokenizerTypeKeyword && t != seq.TokenizerTypeIP {
+			return nil, fmt.Errorf("'ip_range' filter is supported only for keyword and ip fields")
 		}
 
 		lex.Next()
@@ -60,43 +72,116 @@ func parseSeqQLFieldFilter(lex *lexer, mapping seq.Mapping) (*ASTNode, error) {
 		if err != nil {
 			return nil, fmt.Errorf("parsing 'ip_range' filter: %s", err)
 		}
+		if t == seq.TokenizerTypeIP {
+			r.IndexType = t
+		}
+		return &ASTNode{Value: r}, nil
+	}
+
+	if lex.IsKeyword("cidr") {
+		if t != seq.TokenizerTypeKeyword && t != seq.TokenizerTypeIP {
+			return nil, fmt.Errorf("'cidr' filter is supported only for keyword and ip fields")
+		}
+
+		lex.Next()
+		r, err := parseFilterCIDR(lex, fieldName)
+		if err != nil {
+			return nil, fmt.Errorf("parsing 'cidr' filter: %s", err)
+		}
+		if t == seq.TokenizerTypeIP {
+			r.IndexType = t
+		}
 		return &ASTNode{Value: r}, nil
 	}
 
+	if lex.IsKeyword("phrase") {
+		// Query is parsed without mapping on proxy, so the field type is unknown there.
+		if mapping != nil && t != seq.TokenizerTypeText {
+			return nil, fmt.Errorf("'phrase' filter is supported only for text fields")
+		}
+
+		lex.Next()
+		ast, err := parseFilterPhrase(lex, fieldName, mapping, caseSensitive)
+		if err != nil {
+			return nil, fmt.Errorf("parsing 'phrase' filter: %s", err)
+		}
+		return ast, nil
+	}
+
+	if lex.IsKeyword("re") {
+		if seq.IsNumericType(t) || t == seq.TokenizerTypeIP {
+			return nil, fmt.Errorf("'re' filter is not supported for numeric and ip fields")
+		}
+
+		lex.Next()
+		ast, err := parseFilterRegexp(lex, fieldName, caseSensitive)
+		if err != nil {
+			return nil, fmt.Errorf("parsing 're' filter: %s", err)
+		}
+		return ast, nil
+	}
+
 	if lex.IsKeyword("in") {
 		lex.Next()
-		ast, err := parseFilterIn(lex, fieldName, t, caseSensitive)
+		if isSubquery(lex) {
+			ast, err := parseSubquery(lex, fieldName, mapping, start)
+			if err != nil {
+				return nil, fmt.Errorf("parsing subquery: %s", err)
+			}
+			return ast, nil
+		}
+		ast, err := parseFilterIn(lex, fieldName, t, a, caseSensitive)
 		if err != nil {
 			return nil, fmt.Errorf("parsing 'in' filter: %s", err)
 		}
 		return ast, nil
 	}
 
-	ast, err := parseFulltextSearchFilter(lex, fieldName, t, caseSensitive)
+	ast, err := parseFulltextSearchFilter(lex, fieldName, t, a, caseSensitive)
 	if err != nil {
 		return nil, err
 	}
 	return ast, nil
 }
 
-func parseFulltextSearchFilter(lex *lexer, fieldName string, t seq.TokenizerType, caseSensitive bool) (*ASTNode, error) {
+func parseFulltextSearchFilter(lex *lexer, fieldName string, t seq.TokenizerType, a *analyzer.Analyzer, caseSensitive bool) (*ASTNode, error) {
 	value, err := parseCompositeToken(lex)
 	if err != nil {
 		return nil, fmt.Errorf("parsing filter value for field %q: %s", fieldName, err)
 	}
 	switch t {
-	case seq.TokenizerTypeKeyword, seq.TokenizerTypePath:
+	case seq.TokenizerTypeKeyword, seq.TokenizerTypePath, seq.TokenizerTypeNgram:
 		terms, err := parseSeqQLKeyword(value, caseSensitive)
 		if err != nil {
 			return nil, fmt.Errorf("parsing keyword for field %q: %s", fieldName, err)
 		}
-		return &ASTNode{Value: &Literal{Field: fieldName, Terms: terms}}, nil
+		return &ASTNode{Value: &Literal{Field: fieldName, Terms: terms, CaseSensitive: caseSensitive}}, nil
 	case seq.TokenizerTypeText:
-		tokens, err := parseSeqQLText(fieldName, value, caseSensitive)
+		tokens, err := parseSeqQLText(fieldName, value, caseSensitive, a)
 		if err != nil {
 			return nil, fmt.Errorf("parsing text for field %q: %s", fieldName, err)
 		}
 		return buildAndTree(tokens), nil
+	case seq.TokenizerTypeLong, seq.TokenizerTypeDouble, seq.TokenizerTypeDate:
+		terms, err := parseSeqQLKeyword(value, true)
+		if err != nil {
+			return nil, fmt.Errorf("parsing number for field %q: %s", fieldName, err)
+		}
+		token, err := newNumericFilter(fieldName, t, terms)
+		if err != nil {
+			return nil, fmt.Errorf("parsing number for field %q: %s", fieldName, err)
+		}
+		return newTokenNode(token), nil
+	case seq.TokenizerTypeIP:
+		terms, err := parseSeqQLKeyword(value, true)
+		if err != nil {
+			return nil, fmt.Errorf("parsing ip for field %q: %s", fieldName, err)
+		}
+		token, err := newIPFilter(fieldName, terms)
+		if err != nil {
+			return nil, fmt.Errorf("parsing ip for field %q: %s", fieldName, err)
+		}
+		return newTokenNode(token), nil
 	default:
 		panic(fmt.Errorf("BUG: unexpected index type: %d", t))
 	}
@@ -109,7 +194,7 @@ func parseFulltextSearchFilter(lex *lexer, fieldName string, t seq.TokenizerType
 //
 //	service:in(auth-api, api-gateway, clickhouse-shard-*)
 //	phone:in(`+7 999 ** **`, '+995'*)
-func parseFilterIn(lex *lexer, fieldName string, t seq.TokenizerType, caseSensitive bool) (*ASTNode, error) {
+func parseFilterIn(lex *lexer, fieldName string, t seq.TokenizerType, a *analyzer.Analyzer, caseSensitive bool) (*ASTNode, error) {
 	if !lex.IsKeyword("(") {
 		return nil, fmt.Errorf("expected '(', got %q", lex.Token)
 	}
@@ -119,14 +204,14 @@ func parseFilterIn(lex *lexer, fieldName string, t seq.TokenizerType, caseSensit
 		return nil, fmt.Errorf("empty 'in' filter")
 	}
 
-	textFilter, err := parseFulltextSearchFilter(lex, fieldName, t, caseSensitive)
+	textFilter, err := parseFulltextSearchFilter(lex, fieldName, t, a, caseSensitive)
 	if err != nil {
 		return nil, err
 	}
 	root := textFilter
 	for lex.IsKeyword(",") {
 		lex.Next()
-		textFilter, err := parseFulltextSearchFilter(lex, fieldName, t, caseSensitive)
+		textFilter, err := parseFulltextSearchFilter(lex, fieldName, t, a, caseSensitive)
 		if err != nil {
 			return nil, err
 		}
@@ -251,12 +336,14 @@ func parseSeqQLKeyword(token string, caseSensitive bool) ([]Term, error) {
 	return terms, nil
 }
 
-func parseSeqQLText(field, token string, sensitive bool) ([]Token, error) {
+// parseSeqQLText splits the value of text field into words the same way as text tokenizer does.
+// Analyzer of the field, if any, is applied to the words.
+func parseSeqQLText(field, token string, sensitive bool, a *analyzer.Analyzer) ([]Token, error) {
 	if token == "" {
 		return []Token{&Literal{Field: field, Terms: []Term{newTextTerm("")}}}, nil
 	}
 	var tokens []Token
-	current := &Literal{Field: field}
+	current := &Literal{Field: field, CaseSensitive: sensitive}
 
 	term := Term{Kind: TermText}
 	for token != "" {
@@ -284,7 +371,7 @@ func parseSeqQLText(field, token string, sensitive bool) ([]Token, error) {
 
 		if len(current.Terms) != 0 {
 			tokens = append(tokens, current)
-			current = &Literal{Field: field}
+			current = &Literal{Field: field, CaseSensitive: sensitive}
 		}
 		token = token[size:]
 	}
@@ -303,5 +390,48 @@ func parseSeqQLText(field, token string, sensitive bool) ([]Token, error) {
 			Terms: []Term{{Kind: TermText, Data: ""}},
 		})
 	}
+	if a != nil {
+		return analyzeLiterals(tokens, a)
+	}
 	return tokens, nil
 }
+
+// analyzeLiterals applies analyzer to the words of the query the same way as to the indexed words.
+// Words with wildcards can't be stemmed, so only lowercase and ASCII folding are applied to them.
+// Rejected words, e.g. stop words, are removed. If all the words are rejected, an error is returned,
+// because such words are not indexed and the query can't match anything.
+func analyzeLiterals(tokens []Token, a *analyzer.Analyzer) ([]Token, error) {
+	res := make([]Token, 0, len(tokens))
+	for _, token := range tokens {
+		literal, ok := token.(*Literal)
+		if !ok {
+			res = append(res, token)
+			continue
+		}
+		if len(literal.Terms) == 1 && literal.Terms[0].Kind == TermText {
+			if literal.Terms[0].Data == "" {
+				res = append(res, literal)
+				continue
+			}
+			word, ok := a.AnalyzeString(literal.Terms[0].Data)
+			if !ok {
+				continue
+			}
+			res = append(res, &Literal{Field: literal.Field, Terms: []Term{newTextTerm(word)}, CaseSensitive: literal.CaseSensitive})
+			continue
+		}
+
+		terms := make([]Term, 0, len(literal.Terms))
+		for _, term := range literal.Terms {
+			if term.Kind == TermText {
+				term.Data = a.NormalizeString(term.Data)
+			}
+			terms = append(terms, term)
+		}
+		res = append(res, &Literal{Field: literal.Field, Terms: terms, CaseSensitive: literal.CaseSensitive})
+	}
+	if len(res) == 0 {
+		return nil, errors.New("query consists only of stop words, which are not indexed")
+	}
+	return res, nil
+}
@@ -1,7 +1,9 @@
 package parser
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,7 +43,7 @@ func TestLexer(t *testing.T) {
 func TestSeqQLAll(t *testing.T) {
 	t.Parallel()
 
-	mapping := seq.Mapping{
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"k8s_namespace":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"k8s_pod":         seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"service":         seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
@@ -56,7 +58,7 @@ func TestSeqQLAll(t *testing.T) {
 		"*":               seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"m":               seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"OR":              seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-	}
+	}}
 	test := func(originalQuery, expected string) {
 		t.Helper()
 		parsedOriginal, err := ParseSeqQL(originalQuery, mapping)
@@ -259,10 +261,506 @@ func TestSeqQLCaseSensitive(t *testing.T) {
 	test("_exists_: `AbCdEf`", "_exists_:AbCdEf")
 }
 
+func TestSeqQLNumeric(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"bytes":    seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+		"duration": seq.NewSingleType(seq.TokenizerTypeDouble, "", 0),
+	}}
+	test := func(in, out string) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, out, seqql.SeqQLString())
+
+		r, ok := seqql.Root.Value.(*Range)
+		if ok {
+			require.Equal(t, mapping.Fields[r.Field].Main.TokenizerType, r.IndexType)
+		}
+	}
+	test("bytes:42", "bytes:[42, 42]")
+	test("bytes:*", "bytes:*")
+	test("bytes:[1, 100)", "bytes:[1, 100)")
+	test("bytes:(*, 100]", "bytes:(*, 100]")
+	test("duration:[-0.5, 1e3]", "duration:[-0.5, 1e3]")
+	test("duration:1.5", "duration:[1.5, 1.5]")
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQL(in, mapping)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr("bytes:abc", `invalid long value "abc"`)
+	testErr("bytes:1.5", `invalid long value "1.5"`)
+	testErr("duration:[nan, 1]", `invalid double value "nan"`)
+	testErr("bytes:4*", "wildcards are not supported for long fields")
+}
+
+func TestSeqQLDate(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"expires_at": seq.NewSingleType(seq.TokenizerTypeDate, "", 0),
+		"keyword":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	test := func(in, out string) {
+		t.Helper()
+		seqql, err := ParseSeqQLAt(in, mapping, now)
+		require.NoError(t, err)
+		require.Equal(t, out, seqql.SeqQLString())
+
+		parsedOut, err := ParseSeqQLAt(out, mapping, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, seqql, parsedOut)
+	}
+	test("expires_at:[2024-01-01T00:00:00Z, now-1h]", `expires_at:["2024-01-01T00:00:00Z", "2024-01-02T02:04:05Z"]`)
+	test("expires_at:(2024-01-01T00:00:00+03:00, *]", `expires_at:("2024-01-01T00:00:00+03:00", *]`)
+	test(`expires_at:"2024-01-01 10:00:00"`, `expires_at:["2024-01-01 10:00:00", "2024-01-01 10:00:00"]`)
+	test("expires_at:[now-1d, now]", `expires_at:["2024-01-01T03:04:05Z", "2024-01-02T03:04:05Z"]`)
+	// Relative dates are resolved only for date fields.
+	test("keyword:[now-1d, now]", "keyword:[now-1d, now]")
+
+	_, err := ParseSeqQL("expires_at:[yesterday, now]", mapping)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid date value "yesterday"`)
+}
+
+func TestSeqQLTime(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"level": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mid := func(s string) seq.MID {
+		t.Helper()
+		d, err := time.Parse(time.RFC3339Nano, s)
+		require.NoError(t, err)
+		return seq.MID(d.UnixMilli())
+	}
+	test := func(in, out string, expected *TimeRange) {
+		t.Helper()
+		seqql, err := ParseSeqQLAt(in, mapping, now)
+		require.NoError(t, err)
+		require.Equal(t, expected, seqql.Time)
+		require.Equal(t, out, seqql.SeqQLString())
+
+		parsedOut, err := ParseSeqQLAt(out, mapping, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, seqql, parsedOut)
+	}
+
+	test("_time:[now-15m, now]", `_time:["2024-01-02T02:49:05Z", "2024-01-02T03:04:05Z"]`,
+		&TimeRange{From: mid("2024-01-02T02:49:05Z"), To: mid("2024-01-02T03:04:05Z")})
+	test("level:error and _time:>now-1d", `_time:["2024-01-01T03:04:05.001Z", *] and level:error`,
+		&TimeRange{From: mid("2024-01-01T03:04:05.001Z"), To: math.MaxUint64})
+	test("_time:>=2024-01-01T00:00:00Z and (level:error or level:warn) and _time:<now", `_time:["2024-01-01T00:00:00Z", "2024-01-02T03:04:04.999Z"] and (level:error or level:warn)`,
+		&TimeRange{From: mid("2024-01-01T00:00:00Z"), To: mid("2024-01-02T03:04:04.999Z")})
+	test(`_time:<="2024-01-01 10:00:00" | fields level`, `_time:[*, "2024-01-01T10:00:00Z"] | fields level`,
+		&TimeRange{From: 0, To: mid("2024-01-01T10:00:00Z")})
+	test("level:error", "level:error", nil)
+
+	// Intersection of the ranges is empty.
+	seqql, err := ParseSeqQLAt("_time:[now-1h, now-30m] and _time:(now-10m, now]", mapping, now)
+	require.NoError(t, err)
+	from, to := seqql.Time.Intersect(0, math.MaxUint64)
+	require.Greater(t, from, to)
+
+	from, to = (*TimeRange)(nil).Intersect(1, 2)
+	require.Equal(t, seq.MID(1), from)
+	require.Equal(t, seq.MID(2), to)
+	from, to = (&TimeRange{From: 5, To: 10}).Intersect(1, 7)
+	require.Equal(t, seq.MID(5), from)
+	require.Equal(t, seq.MID(7), to)
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQLAt(in, mapping, now)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr("_time:now", "expected range like '[now-1h, now]' or comparison like '>now-1h'")
+	testErr("_time:[yesterday, now]", `invalid date value "yesterday"`)
+	testErr("_time:>", "unexpected end of query")
+	testErr("level:error or _time:>now-1h", `"_time" filter can be joined with other filters only by 'and'`)
+	testErr("not _time:>now-1h", `"_time" filter can be joined with other filters only by 'and'`)
+}
+
+func TestSeqQLIP(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"client_ip": seq.NewSingleType(seq.TokenizerTypeIP, "", 0),
+		"keyword":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	test := func(in, out string) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, out, seqql.SeqQLString())
+
+		parsedOut, err := ParseSeqQL(out, mapping)
+		require.NoError(t, err)
+		require.Equal(t, seqql, parsedOut)
+	}
+	test("client_ip:10.0.0.1", "client_ip:10.0.0.1")
+	test(`client_ip:"2001:0DB8:0:0::1"`, `client_ip:"2001:db8::1"`)
+	test("client_ip:*", "client_ip:*")
+	test("client_ip:cidr(10.0.0.0/8)", "client_ip:ip_range(10.0.0.0, 10.255.255.255)")
+	test(`client_ip:cidr("2001:db8::/32")`, `client_ip:ip_range("2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff")`)
+	test("client_ip:ip_range(10.0.0.1, 10.0.0.5)", "client_ip:ip_range(10.0.0.1, 10.0.0.5)")
+	test("client_ip:in(10.0.0.1, 10.0.0.2)", "(client_ip:10.0.0.1 or client_ip:10.0.0.2)")
+	test("keyword:cidr(192.168.0.0/16)", "keyword:ip_range(192.168.0.0, 192.168.255.255)")
+	test("keyword:cidr(10.0.0.1/32)", "keyword:ip_range(10.0.0.1, 10.0.0.1)")
+	test("keyword:ip_range(10.0.0.1, 10.0.0.1)", "keyword:ip_range(10.0.0.1, 10.0.0.1)")
+	test(`keyword:cidr("2001:db8::1/128")`, `keyword:ip_range("2001:db8::1", "2001:db8::1")`)
+	test("client_ip:cidr(10.0.0.1/32)", "client_ip:10.0.0.1")
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQL(in, mapping)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr("client_ip:localhost", `invalid ip value "localhost"`)
+	testErr("client_ip:10.*", "wildcards are not supported for ip fields")
+	testErr("client_ip:[10.0.0.1, 10.0.0.5]", "range filter is not supported for ip field")
+	testErr("client_ip:cidr(10.0.0.1)", "parsing 'cidr' filter")
+	testErr("client_ip:cidr()", "empty 'cidr' filter")
+}
+
+func TestSeqQLNgram(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"message": seq.NewSingleType(seq.TokenizerTypeNgram, "", 0),
+		"error": {
+			Main: seq.MappingType{Title: "error", TokenizerType: seq.TokenizerTypeText},
+			All: []seq.MappingType{
+				{Title: "error", TokenizerType: seq.TokenizerTypeText},
+				{Title: "error.ngram", TokenizerType: seq.TokenizerTypeNgram, MinGram: 2, MaxGram: 4},
+			},
+		},
+		"error.ngram": {
+			Main: seq.MappingType{Title: "error.ngram", TokenizerType: seq.TokenizerTypeNgram, MinGram: 2, MaxGram: 4},
+			All:  []seq.MappingType{{Title: "error.ngram", TokenizerType: seq.TokenizerTypeNgram, MinGram: 2, MaxGram: 4}},
+		},
+	}}
+	test := func(in string, expected Token) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, expected, seqql.Root.Value)
+		require.Equal(t, in, seqql.SeqQLString())
+
+		parsedOut, err := ParseSeqQL(seqql.SeqQLString(), mapping)
+		require.NoError(t, err)
+		require.Equal(t, seqql, parsedOut)
+	}
+
+	test("message:*out*", &Substring{
+		Field:  "message",
+		Source: "message",
+		Value:  "out",
+		Grams:  []string{"out"},
+	})
+	test("message:*timeout*", &Substring{
+		Field:  "message",
+		Source: "message",
+		Value:  "timeout",
+		Grams:  []string{"tim", "ime", "meo", "eou", "out"},
+		Verify: true,
+	})
+	test("message:*тайм*", &Substring{
+		Field:  "message",
+		Source: "message",
+		Value:  "тайм",
+		Grams:  []string{"тай", "айм"},
+		Verify: true,
+	})
+	test("error.ngram:*dead*", &Substring{
+		Field:  "error.ngram",
+		Source: "error",
+		Value:  "dead",
+		Grams:  []string{"dead"},
+	})
+	test("error.ngram:*deadlock*", &Substring{
+		Field:  "error.ngram",
+		Source: "error",
+		Value:  "deadlock",
+		Grams:  []string{"dead", "eadl", "adlo", "dloc", "lock"},
+		Verify: true,
+	})
+
+	// Too short values and other wildcards are searched as is.
+	test("message:*ou*", &Literal{Field: "message", Terms: []Term{
+		{Kind: TermSymbol, Data: "*"}, {Kind: TermText, Data: "ou"}, {Kind: TermSymbol, Data: "*"},
+	}})
+	test("message:time*", &Literal{Field: "message", Terms: []Term{
+		{Kind: TermText, Data: "time"}, {Kind: TermSymbol, Data: "*"},
+	}})
+}
+
+func TestSeqQLPhrase(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	test := func(in string, expected Token) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, expected, seqql.Root.Value)
+		require.Equal(t, in, seqql.SeqQLString())
+	}
+
+	test(`message:phrase("connection reset by peer")`, &Phrase{
+		Field:  "message",
+		Source: "message",
+		Words:  []string{"connection", "reset", "by", "peer"},
+	})
+
+	// Phrase is split into words the same way as text field values.
+	seqql, err := ParseSeqQL(`message:phrase("request: failed")`, mapping)
+	require.NoError(t, err)
+	require.Equal(t, `message:phrase("request failed")`, seqql.SeqQLString())
+
+	// Single word is searched as a literal.
+	seqql, err = ParseSeqQL(`message:phrase(timeout)`, mapping)
+	require.NoError(t, err)
+	require.Equal(t, &Literal{Field: "message", Terms: []Term{{Kind: TermText, Data: "timeout"}}}, seqql.Root.Value)
+
+	// Field types are unknown without mapping.
+	_, err = ParseSeqQL(`service:phrase("a b") | fields message`, nil)
+	require.NoError(t, err)
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQL(in, mapping)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr(`service:phrase("a b")`, "'phrase' filter is supported only for text fields")
+	testErr(`message:phrase()`, "empty 'phrase' filter")
+	testErr(`message:phrase(" ")`, "empty 'phrase' filter")
+	testErr(`message:phrase("!?")`, "empty 'phrase' filter")
+	testErr(`message:phrase("conn* reset")`, "wildcards are not supported in 'phrase' filter")
+	testErr(`message:phrase("a b"`, "expected ')'")
+}
+
+func TestSeqQLRegexp(t *testing.T) {
+	t.Parallel()
+
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"trace_id": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"message":  seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+		"status":   seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+	}}
+	test := func(in, out string, expected Token) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, expected, seqql.Root.Value)
+		require.Equal(t, out, seqql.SeqQLString())
+
+		parsedOut, err := ParseSeqQL(seqql.SeqQLString(), mapping)
+		require.NoError(t, err)
+		require.Equal(t, seqql, parsedOut)
+	}
+
+	test(`trace_id:re("ab[0-9]+-\\d{3}")`, `trace_id:re("ab[0-9]+-\\d{3}")`, &Regexp{
+		Field:   "trace_id",
+		Pattern: `ab[0-9]+-\d{3}`,
+	})
+	test("trace_id:re(`ab.*-\\d`)", `trace_id:re("ab.*-\\d")`, &Regexp{
+		Field:   "trace_id",
+		Pattern: `ab.*-\d`,
+	})
+	test(`message:re("(conn|sock)et.*")`, `message:re("(conn|sock)et.*")`, &Regexp{
+		Field:   "message",
+		Pattern: `(conn|sock)et.*`,
+	})
+
+	prefix := func(pattern string, caseSensitive bool) string {
+		return (&Regexp{Pattern: pattern, CaseSensitive: caseSensitive}).Prefix()
+	}
+	require.Equal(t, "ab", prefix(`ab[0-9]+`, true))
+	require.Equal(t, "Ab", prefix(`Ab[0-9]+`, true))
+	require.Equal(t, "ab", prefix(`Ab[0-9]+`, false))
+	require.Equal(t, "", prefix(`.*ab`, true))
+	require.Equal(t, "", prefix(`(?i)ab`, true))
+	require.Equal(t, "a", prefix(`ab|ac`, true))
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQL(in, mapping)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr(`status:re("2.*")`, "'re' filter is not supported for numeric and ip fields")
+	testErr(`trace_id:re()`, "empty 're' filter")
+	testErr(`trace_id:re("ab[")`, "parsing 're' filter: error parsing regexp")
+	testErr(`trace_id:re("ab"`, "expected ')'")
+}
+
+func TestSeqQLSubquery(t *testing.T) {
+	t.Parallel()
+
+	test := func(in, out string) []*Subquery {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, nil)
+		require.NoError(t, err)
+		require.Equal(t, out, seqql.SeqQLString())
+		return seqql.Subqueries
+	}
+
+	subqueries := test(`trace_id:in(select trace_id where level:error)`,
+		`trace_id:in(select trace_id where level:error | limit 1000)`)
+	require.Len(t, subqueries, 1)
+	require.Equal(t, "trace_id", subqueries[0].Field)
+	require.Equal(t, "trace_id", subqueries[0].Select)
+	require.Equal(t, "level:error", subqueries[0].Query)
+	require.Equal(t, 1000, subqueries[0].Limit)
+
+	subqueries = test(`service:api and user:in( select user_id where (status:500 or status:502) | head 5 ) | fields user`,
+		`(service:api and user:in(select user_id where (status:500 or status:502) | limit 5)) | fields user`)
+	require.Len(t, subqueries, 1)
+	require.Equal(t, "(status:500 or status:502)", subqueries[0].Query)
+	require.Equal(t, 5, subqueries[0].Limit)
+
+	// Subqueries of the inner queries are resolved with them.
+	subqueries = test(`a:in(select b where b:in(select c where d:1)) or e:in(select e where x:y* | limit 10)`,
+		`(a:in(select b where b:in(select c where d:1 | limit 1000) | limit 1000) or e:in(select e where x:y* | limit 10))`)
+	require.Len(t, subqueries, 2)
+	require.Equal(t, "b:in(select c where d:1)", subqueries[0].Query)
+	require.Equal(t, "x:y*", subqueries[1].Query)
+
+	// Value 'select' is quoted to not be confused with subquery.
+	test(`trace_id:in("select", b)`, `(trace_id:"select" or trace_id:b)`)
+
+	testErr := func(in, errText string) {
+		t.Helper()
+		_, err := ParseSeqQL(in, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errText)
+	}
+	testErr(`trace_id:in(select where level:error)`, "missing 'where' after selected field")
+	testErr(`trace_id:in(select trace_id level:error)`, "missing 'where' after selected field")
+	testErr(`trace_id:in(select trace_id where)`, "parsing subquery: parsing field name")
+	testErr(`trace_id:in(select trace_id where level:error | fields a)`, "only 'limit' pipe is supported")
+	testErr(`trace_id:in(select trace_id where level:error | limit 0)`, "expected positive number of values")
+	testErr(`trace_id:in(select trace_id where level:error | limit 10001)`, "limit 10001 exceeds maximum of 10000 values")
+	testErr(`trace_id:in(select trace_id where level:error`, "expected ')'")
+}
+
+func TestReplaceSubqueries(t *testing.T) {
+	t.Parallel()
+
+	test := func(in string, values [][]string, out string) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, nil)
+		require.NoError(t, err)
+		require.Equal(t, out, ReplaceSubqueries(in, seqql.Subqueries, values))
+	}
+
+	test(`trace_id:in(select trace_id where level:error | limit 10)`, [][]string{{"a", "b*"}},
+		`trace_id:in("a", "b\*")`)
+	test(`level:error and ( "trace id" : in ( select id where a:b ) ) | stats count()`, [][]string{{`"x"`}},
+		`level:error and ( "trace id":in("\"x\"") ) | stats count()`)
+	test(`a:in(select a where b:1) or c:in(select c where d:in(select d where e:1))`, [][]string{{"1"}, {}},
+		`a:in("1") or (not _all_:*)`)
+	test(`# comment
+not a:in(select a where b:1 # inner comment
+)`, [][]string{{"1", "2"}}, `# comment
+not a:in("1", "2")`)
+}
+
+func TestSeqQLAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	mapping, err := seq.ReadMapping([]byte(`
+analyzers:
+  - name: russian
+    stopwords: [_russian_]
+    stemmer: russian
+mapping-list:
+  - name: message
+    type: text
+    analyzer: russian
+  - name: text
+    type: text
+`))
+	require.NoError(t, err)
+
+	test := func(in, expected string) {
+		t.Helper()
+		seqql, err := ParseSeqQL(in, mapping)
+		require.NoError(t, err)
+		require.Equal(t, expected, seqql.SeqQLString())
+	}
+
+	// Words of the query are analyzed the same way as the indexed ones.
+	test(`message:Ошибки`, `message:ошибк`)
+	test(`message:"Ошибка при подключении"`, `(message:ошибк and message:подключен)`)
+	test(`message:in(ошибкой, таймаутом)`, `(message:ошибк or message:таймаут)`)
+	// Words with wildcards are not stemmed.
+	test(`message:ОШИБК*`, `message:ошибк*`)
+	// Stop words are not indexed, so query of only stop words is rejected.
+	_, err = ParseSeqQL(`message:"при"`, mapping)
+	require.EqualError(t, err, `parsing text for field "message": query consists only of stop words, which are not indexed`)
+	_, err = ParseSeqQL(`message:phrase("и при")`, mapping)
+	require.Error(t, err)
+	_, err = ParseSeqQL(`message:phrase("при")`, mapping)
+	require.EqualError(t, err, `parsing 'phrase' filter: query consists only of stop words, which are not indexed`)
+	// Single analyzed word of the phrase is searched as a literal.
+	test(`message:phrase("Ошибками")`, `message:ошибк`)
+	test(`message:phrase("при ошибке")`, `message:ошибк`)
+	// Fields without analyzer are not affected.
+	test(`text:ошибки`, `text:ошибки`)
+
+	seqql, err := ParseSeqQL(`message:phrase("ошибка при подключении")`, mapping)
+	require.NoError(t, err)
+	require.Equal(t, &Phrase{
+		Field:    "message",
+		Source:   "message",
+		Words:    []string{"ошибк", "подключен"},
+		Analyzer: mapping.Fields["message"].Main.Analyzer,
+	}, seqql.Root.Value)
+}
+
+func TestSeqQLTemplates(t *testing.T) {
+	t.Parallel()
+
+	mapping, err := seq.ReadMapping([]byte(`
+mapping-templates:
+  - match: "*.payload"
+    index: false
+  - match: "*_id"
+    type: keyword
+  - match: "*.duration_ms"
+    type: long
+`))
+	require.NoError(t, err)
+
+	seqql, err := ParseSeqQL(`user_id:U1 and request.duration_ms:[100, *]`, mapping)
+	require.NoError(t, err)
+	require.Equal(t, `(user_id:u1 and request.duration_ms:[100, *])`, seqql.SeqQLString())
+	r := seqql.Root.Children[1].Value.(*Range)
+	require.Equal(t, seq.TokenizerTypeLong, r.IndexType)
+
+	_, err = ParseSeqQL(`request_id.payload:x`, mapping)
+	require.EqualError(t, err, `field "request_id.payload" is not indexed`)
+}
+
 func TestParseSeqQLNotIndexed(t *testing.T) {
 	t.Parallel()
 
-	q, err := ParseSeqQL("not_indexed_field:value", seq.Mapping{})
+	q, err := ParseSeqQL("not_indexed_field:value", &seq.Mapping{})
 	require.NotNilf(t, err, "%+v", q)
 	require.Equal(t, `field "not_indexed_field" is not indexed`, err.Error())
 }
@@ -385,7 +883,7 @@ func TestParseSeqQLError(t *testing.T) {
 	test(`keyword:ip_range(10.0.0.1, 10.0.0.256)`, `parsing 'ip_range' filter: ParseAddr("10.0.0.256"): IPv4 field has value >255`)
 	test(`keyword:ip_range(10.0.0.1, "invalid")`, `parsing 'ip_range' filter: ParseAddr("invalid"): unable to parse IP`)
 	test(`keyword:ip_range(10.0.0.1/33)`, `parsing 'ip_range' filter: netip.ParsePrefix("10.0.0.1/33"): prefix length out of range`)
-	test(`keyword:ip_range(10.0.0.1, 10.0.0.0)`, `parsing 'ip_range' filter: first ip "10.0.0.1" is greater or equal than second ip "10.0.0.0"`)
+	test(`keyword:ip_range(10.0.0.1, 10.0.0.0)`, `parsing 'ip_range' filter: first ip "10.0.0.1" is greater than second ip "10.0.0.0"`)
 	test(`keyword:ip_range(192.168.1.2, 192.168.1.3`, `parsing 'ip_range' filter: expected ')', got ""`)
 	test(`keyword:ip_range(192.168.1.2/24`, `parsing 'ip_range' filter: expected ')', got ""`)
 	test(`keyword:ip_range(192.168.1.2 192.168.1.3)`, `parsing 'ip_range' filter: expected ',' keyword, got "192.168.1.3"`)
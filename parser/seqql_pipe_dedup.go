@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PipeDedup keeps the first document for each distinct tuple of the fields values, e.g. `dedup trace_id`.
+type PipeDedup struct {
+	Fields []string
+}
+
+func (d *PipeDedup) Name() string {
+	return "dedup"
+}
+
+func (d *PipeDedup) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("dedup ")
+	for i, field := range d.Fields {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		o.WriteString(quoteTokenIfNeeded(field))
+	}
+}
+
+func parsePipeDedup(lex *lexer) (*PipeDedup, error) {
+	if !lex.IsKeyword("dedup") {
+		return nil, fmt.Errorf("missing 'dedup' keyword")
+	}
+	lex.Next()
+
+	d := &PipeDedup{}
+	for {
+		if lex.IsKeywords("|", ",", "") {
+			return nil, fmt.Errorf("expected field name, got: %q", lex.Token)
+		}
+		field, err := parseFieldName(lex)
+		if err != nil {
+			return nil, err
+		}
+		d.Fields = append(d.Fields, field)
+		if !lex.IsKeyword(",") {
+			break
+		}
+		lex.Next()
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected ',', got: %q", lex.Token)
+	}
+	return d, nil
+}
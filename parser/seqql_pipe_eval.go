@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PipeRename renames fields of the fetched documents, e.g. `rename a as b, c as d`.
+type PipeRename struct {
+	Fields []RenameField
+}
+
+// RenameField is a single renaming of the 'rename' pipe.
+type RenameField struct {
+	From string
+	To   string
+}
+
+func (r *PipeRename) Name() string {
+	return "rename"
+}
+
+func (r *PipeRename) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("rename ")
+	for i, f := range r.Fields {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		o.WriteString(quoteTokenIfNeeded(f.From) + " as " + quoteTokenIfNeeded(f.To))
+	}
+}
+
+// PipeEval sets fields of the fetched documents to the values of expressions,
+// e.g. `eval latency_s = latency_ms / 1000, is_slow = latency_ms > 500`.
+type PipeEval struct {
+	Fields []EvalField
+}
+
+// EvalField is a single assignment of the 'eval' pipe.
+type EvalField struct {
+	Field string
+	Expr  *Expr
+}
+
+func (e *PipeEval) Name() string {
+	return "eval"
+}
+
+func (e *PipeEval) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("eval ")
+	for i, f := range e.Fields {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		o.WriteString(quoteTokenIfNeeded(f.Field) + " = ")
+		f.Expr.DumpSeqQL(o)
+	}
+}
+
+func parsePipeRename(lex *lexer) (*PipeRename, error) {
+	if !lex.IsKeyword("rename") {
+		return nil, fmt.Errorf("missing 'rename' keyword")
+	}
+	lex.Next()
+
+	r := &PipeRename{}
+	for {
+		from, err := parsePipeField(lex)
+		if err != nil {
+			return nil, err
+		}
+		if !lex.IsKeyword("as") {
+			return nil, fmt.Errorf("missing 'as' keyword after %q", from)
+		}
+		lex.Next()
+		to, err := parsePipeField(lex)
+		if err != nil {
+			return nil, err
+		}
+		r.Fields = append(r.Fields, RenameField{From: from, To: to})
+		if !lex.IsKeyword(",") {
+			break
+		}
+		lex.Next()
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected ',', got: %q", lex.Token)
+	}
+	return r, nil
+}
+
+func parsePipeEval(lex *lexer) (*PipeEval, error) {
+	if !lex.IsKeyword("eval") {
+		return nil, fmt.Errorf("missing 'eval' keyword")
+	}
+	lex.Next()
+
+	e := &PipeEval{}
+	for {
+		field, err := parsePipeField(lex)
+		if err != nil {
+			return nil, err
+		}
+		if !lex.IsKeyword("=") {
+			return nil, fmt.Errorf("missing '=' after %q", field)
+		}
+		lex.Next()
+		expr, err := parseExpr(lex)
+		if err != nil {
+			return nil, err
+		}
+		e.Fields = append(e.Fields, EvalField{Field: field, Expr: expr})
+		if !lex.IsKeyword(",") {
+			break
+		}
+		lex.Next()
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected ',' or operator, got: %q", lex.Token)
+	}
+	return e, nil
+}
+
+// parsePipeField parses name of the field in the list of the pipe arguments.
+func parsePipeField(lex *lexer) (string, error) {
+	if lex.IsKeywords("|", ",", "=", "") {
+		return "", fmt.Errorf("expected field name, got: %q", lex.Token)
+	}
+	return parseFieldName(lex)
+}
@@ -0,0 +1,206 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// defaultExtractField is the field which text is parsed by 'extract' pipe if 'from' is not set.
+const defaultExtractField = "message"
+
+// PipeExtract creates fields of the fetched documents from the text of the field,
+// e.g. `extract "user=<user> took <duration>ms" from message` or `extract re("user=(?P<user>\w+)")`.
+// Pipe `parse` is an alias of it.
+type PipeExtract struct {
+	// Pattern is a text with placeholders like `<user>` or a regular expression with named groups.
+	Pattern string
+	// Regexp is the compiled Pattern if it is a regular expression.
+	Regexp *regexp.Regexp
+	// Texts are the parts of the text pattern around placeholders, there is one more text than Fields.
+	Texts []string
+	// Fields are names of the created fields, placeholder `<_>` is skipped and has empty name.
+	Fields []string
+	From   string
+}
+
+func (e *PipeExtract) Name() string {
+	return "extract"
+}
+
+func (e *PipeExtract) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("extract ")
+	if e.Regexp != nil {
+		o.WriteString("re(" + quote(e.Pattern) + ")")
+	} else {
+		o.WriteString(quote(e.Pattern))
+	}
+	o.WriteString(" from " + quoteTokenIfNeeded(e.From))
+}
+
+// Match returns the values of the Fields extracted from the text, or false if the text does not match the pattern.
+// Values of the fields which are not matched by optional groups of the regular expression are empty.
+func (e *PipeExtract) Match(text string, values []string) ([]string, bool) {
+	values = values[:0]
+	if e.Regexp != nil {
+		m := e.Regexp.FindStringSubmatchIndex(text)
+		if m == nil {
+			return values, false
+		}
+		for i := 1; i < len(m)/2; i++ {
+			v := ""
+			if m[2*i] >= 0 {
+				v = text[m[2*i]:m[2*i+1]]
+			}
+			values = append(values, v)
+		}
+		return values, true
+	}
+
+	// Prefix of the pattern is looked up anywhere, each placeholder takes the text up to the next part.
+	i := strings.Index(text, e.Texts[0])
+	if i < 0 {
+		return values, false
+	}
+	text = text[i+len(e.Texts[0]):]
+	for _, next := range e.Texts[1:] {
+		i := len(text)
+		if next != "" {
+			if i = strings.Index(text, next); i < 0 {
+				return values, false
+			}
+		}
+		values = append(values, text[:i])
+		text = text[i+len(next):]
+	}
+	return values, true
+}
+
+func parsePipeExtract(lex *lexer) (*PipeExtract, error) {
+	if !lex.IsKeywords("extract", "parse") {
+		return nil, fmt.Errorf("missing 'extract' keyword")
+	}
+	lex.Next()
+
+	e := &PipeExtract{From: defaultExtractField}
+	if lex.IsKeyword("re") {
+		lex.Next()
+		if !lex.IsKeyword("(") {
+			return nil, fmt.Errorf("expected '(', got %q", lex.Token)
+		}
+		lex.Next()
+		if err := e.parsePattern(lex); err != nil {
+			return nil, err
+		}
+		if !lex.IsKeyword(")") {
+			return nil, fmt.Errorf("expected ')', got %q", lex.Token)
+		}
+		lex.Next()
+		if err := e.compileRegexp(); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := e.parsePattern(lex); err != nil {
+			return nil, err
+		}
+		if err := e.compileText(); err != nil {
+			return nil, err
+		}
+	}
+
+	if lex.IsKeyword("from") {
+		lex.Next()
+		from, err := parsePipeField(lex)
+		if err != nil {
+			return nil, err
+		}
+		e.From = from
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected 'from', got: %q", lex.Token)
+	}
+	return e, nil
+}
+
+func (e *PipeExtract) parsePattern(lex *lexer) error {
+	if !lex.TokenQuoted {
+		return fmt.Errorf("expected quoted pattern, got: %q", lex.Token)
+	}
+	e.Pattern = strings.ReplaceAll(lex.Token, string(wildcardRune), "*")
+	lex.Next()
+	return nil
+}
+
+func (e *PipeExtract) compileRegexp() error {
+	re, err := regexp.Compile(e.Pattern)
+	if err != nil {
+		return err
+	}
+	e.Regexp = re
+	e.Fields = re.SubexpNames()[1:]
+	if !hasNamedFields(e.Fields) {
+		return fmt.Errorf("regular expression has no named groups like (?P<name>...)")
+	}
+	return nil
+}
+
+// compileText splits the pattern into texts around placeholders `<name>`.
+// Symbol '<' which does not start a placeholder is a part of the text.
+func (e *PipeExtract) compileText() error {
+	rest := e.Pattern
+	text := strings.Builder{}
+	for rest != "" {
+		i := strings.IndexByte(rest, '<')
+		if i < 0 {
+			text.WriteString(rest)
+			break
+		}
+		text.WriteString(rest[:i])
+		rest = rest[i:]
+
+		name, ok := parsePlaceholder(rest)
+		if !ok {
+			text.WriteByte('<')
+			rest = rest[1:]
+			continue
+		}
+		rest = rest[len(name)+2:]
+
+		if len(e.Fields) > 0 && text.Len() == 0 {
+			return fmt.Errorf("placeholders must be separated by text, got: %q", e.Pattern)
+		}
+		e.Texts = append(e.Texts, text.String())
+		text.Reset()
+		if name == "_" {
+			name = ""
+		}
+		e.Fields = append(e.Fields, name)
+	}
+	e.Texts = append(e.Texts, text.String())
+
+	if !hasNamedFields(e.Fields) {
+		return fmt.Errorf("pattern has no placeholders like <name>")
+	}
+	return nil
+}
+
+// parsePlaceholder returns name of the placeholder at the beginning of s like `<user>`.
+func parsePlaceholder(s string) (string, bool) {
+	end := strings.IndexByte(s, '>')
+	if end < 2 {
+		return "", false
+	}
+	name := s[1:end]
+	for _, r := range name {
+		if !isTokenRune(r) && r != '-' {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+func hasNamedFields(fields []string) bool {
+	return slices.ContainsFunc(fields, func(f string) bool { return f != "" })
+}
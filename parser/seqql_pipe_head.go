@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PipeHead keeps the first Limit documents, e.g. `head 50`. Pipe `limit` is an alias of it.
+type PipeHead struct {
+	Limit int
+}
+
+func (h *PipeHead) Name() string {
+	return "head"
+}
+
+func (h *PipeHead) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("head " + strconv.Itoa(h.Limit))
+}
+
+// PipeTail keeps the last Limit documents, e.g. `tail 50`.
+type PipeTail struct {
+	Limit int
+}
+
+func (t *PipeTail) Name() string {
+	return "tail"
+}
+
+func (t *PipeTail) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("tail " + strconv.Itoa(t.Limit))
+}
+
+func parsePipeHead(lex *lexer) (*PipeHead, error) {
+	if !lex.IsKeywords("head", "limit") {
+		return nil, fmt.Errorf("missing 'head' keyword")
+	}
+	lex.Next()
+
+	limit, err := parsePipeLimit(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &PipeHead{Limit: limit}, nil
+}
+
+func parsePipeTail(lex *lexer) (*PipeTail, error) {
+	if !lex.IsKeyword("tail") {
+		return nil, fmt.Errorf("missing 'tail' keyword")
+	}
+	lex.Next()
+
+	limit, err := parsePipeLimit(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &PipeTail{Limit: limit}, nil
+}
+
+// parsePipeLimit parses number of documents, which must be positive.
+func parsePipeLimit(lex *lexer) (int, error) {
+	if lex.IsKeyword("|") || lex.IsEnd() {
+		return 0, fmt.Errorf("missing number of documents")
+	}
+	limit, err := strconv.Atoi(lex.Token)
+	if err != nil || limit <= 0 || lex.TokenQuoted {
+		return 0, fmt.Errorf("expected positive number of documents, got: %q", lex.Token)
+	}
+	lex.Next()
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return 0, fmt.Errorf("unexpected token after number of documents: %q", lex.Token)
+	}
+	return limit, nil
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+// PipeSort orders the found documents by indexed values of the fields, e.g. `sort by status desc, duration`.
+// Documents are sorted by stores, documents with equal values are ordered by time.
+type PipeSort struct {
+	Fields []seq.SortField
+}
+
+func (s *PipeSort) Name() string {
+	return "sort"
+}
+
+func (s *PipeSort) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("sort by ")
+	for i, f := range s.Fields {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		o.WriteString(quoteTokenIfNeeded(f.Field))
+		if f.Desc {
+			o.WriteString(" desc")
+		}
+	}
+}
+
+func parsePipeSort(lex *lexer) (*PipeSort, error) {
+	if !lex.IsKeyword("sort") {
+		return nil, fmt.Errorf("missing 'sort' keyword")
+	}
+	lex.Next()
+	if !lex.IsKeyword("by") {
+		return nil, fmt.Errorf("missing 'by' keyword")
+	}
+	lex.Next()
+
+	s := &PipeSort{}
+	for {
+		if lex.IsKeywords("|", ",", "") {
+			return nil, fmt.Errorf("expected field name, got: %q", lex.Token)
+		}
+		field, err := parseFieldName(lex)
+		if err != nil {
+			return nil, err
+		}
+		f := seq.SortField{Field: field}
+		if lex.IsKeywords("asc", "desc") {
+			f.Desc = lex.IsKeyword("desc")
+			lex.Next()
+		}
+		s.Fields = append(s.Fields, f)
+		if !lex.IsKeyword(",") {
+			break
+		}
+		lex.Next()
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected ',', 'asc' or 'desc', got: %q", lex.Token)
+	}
+	return s, nil
+}
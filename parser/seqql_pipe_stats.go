@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+// StatsFunc is an aggregation function of 'stats' pipe like `count()`, `avg(duration)` or `p99(duration)`.
+type StatsFunc struct {
+	Func seq.AggFunc
+	// Field is an aggregated field, it is empty for count.
+	Field string
+	// Quantile is used only for seq.AggFuncQuantile.
+	Quantile float64
+}
+
+var statsFuncNames = map[string]seq.AggFunc{
+	"count": seq.AggFuncCount,
+	"sum":   seq.AggFuncSum,
+	"min":   seq.AggFuncMin,
+	"max":   seq.AggFuncMax,
+	"avg":   seq.AggFuncAvg,
+}
+
+func (f StatsFunc) DumpSeqQL(o *strings.Builder) {
+	if f.Func == seq.AggFuncQuantile {
+		o.WriteString("p" + strconv.FormatFloat(f.Quantile*100, 'g', 10, 64))
+	} else {
+		for name, fn := range statsFuncNames {
+			if fn == f.Func {
+				o.WriteString(name)
+			}
+		}
+	}
+	o.WriteString("(")
+	if f.Field != "" {
+		o.WriteString(quoteTokenIfNeeded(f.Field))
+	}
+	o.WriteString(")")
+}
+
+// PipeStats calculates aggregations of the found documents, e.g. `stats count(), avg(duration) by service`.
+// Aggregations are calculated by stores, each function is a separate aggregation.
+type PipeStats struct {
+	Funcs []StatsFunc
+	By    []string
+}
+
+func (s *PipeStats) Name() string {
+	return "stats"
+}
+
+func (s *PipeStats) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("stats ")
+	s.dumpArgs(o)
+}
+
+func (s *PipeStats) dumpArgs(o *strings.Builder) {
+	for i, f := range s.Funcs {
+		if i > 0 {
+			o.WriteString(", ")
+		}
+		f.DumpSeqQL(o)
+	}
+	if len(s.By) > 0 {
+		o.WriteString(" by ")
+		for i, field := range s.By {
+			if i > 0 {
+				o.WriteString(", ")
+			}
+			o.WriteString(quoteTokenIfNeeded(field))
+		}
+	}
+}
+
+func parsePipeStats(lex *lexer) (*PipeStats, error) {
+	if !lex.IsKeyword("stats") {
+		return nil, fmt.Errorf("missing 'stats' keyword")
+	}
+	lex.Next()
+	return parseStatsArgs(lex)
+}
+
+// parseStatsArgs parses functions and grouping of 'stats' pipe, it is shared with 'timechart' pipe.
+func parseStatsArgs(lex *lexer) (*PipeStats, error) {
+	s := &PipeStats{}
+	for {
+		f, err := parseStatsFunc(lex)
+		if err != nil {
+			return nil, err
+		}
+		s.Funcs = append(s.Funcs, f)
+		if !lex.IsKeyword(",") {
+			break
+		}
+		lex.Next()
+	}
+
+	if lex.IsKeyword("by") {
+		lex.Next()
+		by, err := parseFieldList(lex)
+		if err != nil {
+			return nil, fmt.Errorf("parsing 'by' fields: %s", err)
+		}
+		if len(by) > seq.MaxGroupByFields {
+			return nil, fmt.Errorf("grouping by more than %d fields is not supported", seq.MaxGroupByFields)
+		}
+		s.By = by
+	}
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("expected ',' or 'by', got: %q", lex.Token)
+	}
+	return s, nil
+}
+
+func parseStatsFunc(lex *lexer) (StatsFunc, error) {
+	if lex.TokenQuoted {
+		return StatsFunc{}, fmt.Errorf("expected function name, got: %q", lex.Token)
+	}
+	name := strings.ToLower(lex.Token)
+	f, err := statsFuncByName(name)
+	if err != nil {
+		return StatsFunc{}, err
+	}
+	lex.Next()
+
+	if !lex.IsKeyword("(") {
+		return StatsFunc{}, fmt.Errorf("missing '(' after %q", name)
+	}
+	lex.Next()
+	if !lex.IsKeyword(")") {
+		f.Field, err = parseFieldName(lex)
+		if err != nil {
+			return StatsFunc{}, fmt.Errorf("parsing %q argument: %s", name, err)
+		}
+	}
+	if !lex.IsKeyword(")") {
+		return StatsFunc{}, fmt.Errorf("missing ')' after %q argument", name)
+	}
+	lex.Next()
+
+	if f.Func == seq.AggFuncCount && f.Field != "" {
+		return StatsFunc{}, fmt.Errorf("'count' has no arguments, use 'by' to count documents by field values")
+	}
+	if f.Func != seq.AggFuncCount && f.Field == "" {
+		return StatsFunc{}, fmt.Errorf("%q requires a field", name)
+	}
+	return f, nil
+}
+
+// statsFuncByName returns function by its name, quantiles are named like p50, p95 or p99.9.
+func statsFuncByName(name string) (StatsFunc, error) {
+	if fn, ok := statsFuncNames[name]; ok {
+		return StatsFunc{Func: fn}, nil
+	}
+	if percentile, ok := strings.CutPrefix(name, "p"); ok {
+		p, err := strconv.ParseFloat(percentile, 64)
+		if !isDecimalNumber(percentile) || err != nil || p < 0 || p > 100 {
+			return StatsFunc{}, fmt.Errorf("invalid percentile %q, expected a number from 0 to 100", name)
+		}
+		return StatsFunc{Func: seq.AggFuncQuantile, Quantile: p / 100}, nil
+	}
+	return StatsFunc{}, fmt.Errorf("unknown function %q", name)
+}
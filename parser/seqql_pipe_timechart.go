@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ozontech/seq-db/util"
+)
+
+// PipeTimechart calculates aggregations of the found documents in time bins of the span,
+// e.g. `timechart span=1m count() by level` or `timechart span=5m avg(duration) by service`.
+// Functions and grouping are the same as of 'stats' pipe, each function is a separate time series aggregation.
+type PipeTimechart struct {
+	Span  time.Duration
+	Funcs []StatsFunc
+	By    []string
+}
+
+func (t *PipeTimechart) Name() string {
+	return "timechart"
+}
+
+func (t *PipeTimechart) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("timechart span=" + FormatSpan(t.Span) + " ")
+	t.Stats().dumpArgs(o)
+}
+
+// Stats returns 'stats' pipe which calculates the functions of the time bin.
+func (t *PipeTimechart) Stats() *PipeStats {
+	return &PipeStats{Funcs: t.Funcs, By: t.By}
+}
+
+var spanUnits = []struct {
+	unit string
+	d    time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+}
+
+// FormatSpan formats the span in the largest unit which divides it, so util.ParseDuration parses it back.
+func FormatSpan(span time.Duration) string {
+	for _, u := range spanUnits {
+		if span%u.d == 0 {
+			return strconv.FormatInt(int64(span/u.d), 10) + u.unit
+		}
+	}
+	return strconv.FormatInt(span.Milliseconds(), 10) + "ms"
+}
+
+func parsePipeTimechart(lex *lexer) (*PipeTimechart, error) {
+	if !lex.IsKeyword("timechart") {
+		return nil, fmt.Errorf("missing 'timechart' keyword")
+	}
+	lex.Next()
+
+	if !lex.IsKeyword("span") {
+		return nil, fmt.Errorf("missing 'span', expected time bin like 'span=1m', got: %q", lex.Token)
+	}
+	lex.Next()
+	if !lex.IsKeyword("=") {
+		return nil, fmt.Errorf("missing '=' after 'span'")
+	}
+	lex.Next()
+	span, err := util.ParseDuration(lex.Token)
+	if err != nil || span < time.Millisecond || lex.TokenQuoted {
+		return nil, fmt.Errorf("expected positive duration like '1m' or '30s', got: %q", lex.Token)
+	}
+	lex.Next()
+
+	stats, err := parseStatsArgs(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &PipeTimechart{Span: span, Funcs: stats.Funcs, By: stats.By}, nil
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+// defaultTopLimit is the number of values returned by 'top' and 'rare' pipes if it is not set.
+const defaultTopLimit = 10
+
+// PipeTop returns the most frequent values of the field with their counts and percentages, e.g. `top 10 service`.
+// Pipe `rare` returns the least frequent values the same way, e.g. `rare 10 error_code`.
+// Values are counted like `stats count() by field`, the rest of the values are summed up in a single bucket.
+type PipeTop struct {
+	Limit int
+	Field string
+	// Rare is true for 'rare' pipe.
+	Rare bool
+}
+
+func (t *PipeTop) Name() string {
+	if t.Rare {
+		return "rare"
+	}
+	return "top"
+}
+
+func (t *PipeTop) DumpSeqQL(o *strings.Builder) {
+	o.WriteString(t.Name() + " " + strconv.Itoa(t.Limit) + " " + quoteTokenIfNeeded(t.Field))
+}
+
+// Stats returns 'stats' pipe which counts the values of the field.
+func (t *PipeTop) Stats() *PipeStats {
+	return &PipeStats{
+		Funcs: []StatsFunc{{Func: seq.AggFuncCount}},
+		By:    []string{t.Field},
+	}
+}
+
+func parsePipeTop(lex *lexer) (*PipeTop, error) {
+	if !lex.IsKeywords("top", "rare") {
+		return nil, fmt.Errorf("missing 'top' keyword")
+	}
+	t := &PipeTop{Limit: defaultTopLimit, Rare: lex.IsKeyword("rare")}
+	lex.Next()
+
+	if !lex.TokenQuoted {
+		if limit, err := strconv.Atoi(lex.Token); err == nil {
+			if limit <= 0 {
+				return nil, fmt.Errorf("expected positive number of values, got: %q", lex.Token)
+			}
+			t.Limit = limit
+			lex.Next()
+		}
+	}
+
+	field, err := parsePipeField(lex)
+	if err != nil {
+		return nil, err
+	}
+	t.Field = field
+
+	if !lex.IsKeyword("|") && !lex.IsEnd() {
+		return nil, fmt.Errorf("unexpected token after field name: %q", lex.Token)
+	}
+	return t, nil
+}
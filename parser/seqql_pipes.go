@@ -14,6 +14,8 @@ type Pipe interface {
 func parsePipes(lex *lexer) ([]Pipe, error) {
 	// Counter of 'fields' pipes.
 	fieldFilters := 0
+	// Counter of 'tail' pipes.
+	tails := 0
 	var pipes []Pipe
 	for !lex.IsEnd() {
 		if !lex.IsKeyword("|") {
@@ -29,13 +31,93 @@ func parsePipes(lex *lexer) ([]Pipe, error) {
 			}
 			pipes = append(pipes, p)
 			fieldFilters++
+		case lex.IsKeyword("where"):
+			p, err := parsePipeWhere(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'where' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("stats"):
+			p, err := parsePipeStats(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'stats' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("sort"):
+			p, err := parsePipeSort(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'sort' pipe: %s", err)
+			}
+			if len(pipes) > 0 {
+				return nil, fmt.Errorf("'sort' pipe must be the first one, since it is calculated by stores")
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeywords("head", "limit"):
+			p, err := parsePipeHead(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'head' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("tail"):
+			p, err := parsePipeTail(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'tail' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+			tails++
+		case lex.IsKeyword("dedup"):
+			p, err := parsePipeDedup(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'dedup' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeywords("extract", "parse"):
+			p, err := parsePipeExtract(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'extract' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("timechart"):
+			p, err := parsePipeTimechart(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'timechart' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeywords("top", "rare"):
+			p, err := parsePipeTop(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'top' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("rename"):
+			p, err := parsePipeRename(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'rename' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
+		case lex.IsKeyword("eval"):
+			p, err := parsePipeEval(lex)
+			if err != nil {
+				return nil, fmt.Errorf("parsing 'eval' pipe: %s", err)
+			}
+			pipes = append(pipes, p)
 		default:
 			return nil, fmt.Errorf("unknown pipe: %s", lex.Token)
 		}
 
+		if len(pipes) > 1 {
+			switch prev := pipes[len(pipes)-2].(type) {
+			case *PipeStats, *PipeTop, *PipeTimechart:
+				return nil, fmt.Errorf("'%s' pipe must be the last one", prev.Name())
+			}
+		}
+
 		if fieldFilters > 1 {
 			return nil, fmt.Errorf("multiple field filters is not allowed")
 		}
+		if tails > 1 {
+			return nil, fmt.Errorf("multiple 'tail' pipes is not allowed")
+		}
 	}
 	return pipes, nil
 }
@@ -85,6 +167,33 @@ func parsePipeFields(lex *lexer) (*PipeFields, error) {
 	}, nil
 }
 
+// PipeWhere keeps only the fetched documents which match the condition.
+type PipeWhere struct {
+	Cond *Expr
+}
+
+func (w *PipeWhere) Name() string {
+	return "where"
+}
+
+func (w *PipeWhere) DumpSeqQL(o *strings.Builder) {
+	o.WriteString("where ")
+	w.Cond.DumpSeqQL(o)
+}
+
+func parsePipeWhere(lex *lexer) (*PipeWhere, error) {
+	if !lex.IsKeyword("where") {
+		return nil, fmt.Errorf("missing 'where' keyword")
+	}
+	lex.Next()
+
+	cond, err := parseCondition(lex)
+	if err != nil {
+		return nil, err
+	}
+	return &PipeWhere{Cond: cond}, nil
+}
+
 func parseFieldList(lex *lexer) ([]string, error) {
 	var fields []string
 	trailingComma := false
@@ -110,6 +219,18 @@ func parseFieldList(lex *lexer) ([]string, error) {
 	return fields, nil
 }
 
+// parseFieldName parses name of the field, wildcards are not allowed.
+func parseFieldName(lex *lexer) (string, error) {
+	field, err := parseCompositeToken(lex)
+	if err != nil {
+		return "", err
+	}
+	if strings.ContainsRune(field, wildcardRune) {
+		return "", fmt.Errorf("wildcards are not allowed in field name: %q", field)
+	}
+	return field, nil
+}
+
 func quoteTokenIfNeeded(token string) string {
 	if !needQuoteToken(token) {
 		return token
@@ -149,7 +270,9 @@ var reservedKeywords = uniqueTokens([]string{
 	"|",
 
 	// Pipe specific keywords.
-	"fields", "except",
+	"fields", "except", "where", "stats", "sort", "by", "asc", "desc",
+	"head", "limit", "tail", "dedup", "rename", "as", "eval",
+	"extract", "parse", "top", "rare", "timechart", "select",
 })
 
 func needQuoteToken(s string) bool {
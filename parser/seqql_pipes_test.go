@@ -2,8 +2,11 @@ package parser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/seq"
 )
 
 func TestParsePipeFields(t *testing.T) {
@@ -38,3 +41,424 @@ func TestParsePipeFieldsExcept(t *testing.T) {
 	test(`* | fields except "_\\message*"`, `* | fields except "_\\message\*"`)
 	test(`* | fields except k8s_namespace`, `* | fields except k8s_namespace`)
 }
+
+func TestParsePipeWhere(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | where payload.status = "FAILED" and latency_ms > 500`, `* | where payload.status = "FAILED" and latency_ms > 500`)
+	test(`* | where latency_ms>=500`, `* | where latency_ms >= 500`)
+	test(`* | where status!=200 or code<=-1.5`, `* | where status != 200 or code <= -1.5`)
+	test(`* | where a = 1 and (b = 2 or c = 3)`, `* | where a = 1 and (b = 2 or c = 3)`)
+	test(`* | where (a = 1 and b = 2) or c = 3`, `* | where a = 1 and b = 2 or c = 3`)
+	test(`* | where not (a = 1 or b = 2)`, `* | where not (a = 1 or b = 2)`)
+	test(`* | where exists(user.id) and not exists(error)`, `* | where exists(user.id) and not exists(error)`)
+	test(`* | where ok = true and err = null`, `* | where ok = true and err = null`)
+	test(`* | where message = "a*b"`, `* | where message = "a\*b"`)
+	test(`* | where k8s-pod = 'api'`, `* | where k8s-pod = "api"`)
+	test(`* | where level = "error" | fields message`, `* | where level = "error" | fields message`)
+	test(`* | fields message | where level = "error"`, `* | fields message | where level = "error"`)
+
+	query, err := ParseSeqQL(`* | where a = 1 and b = 2 and c = 3`, nil)
+	require.NoError(t, err)
+	where := query.Pipes[0].(*PipeWhere)
+	require.Equal(t, ExprAnd, where.Cond.Kind)
+	require.Len(t, where.Cond.Args, 3)
+	require.Equal(t, 1.0, where.Cond.Args[0].Args[1].Number)
+}
+
+func TestParsePipeWhereErrors(t *testing.T) {
+	test := func(q string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+	}
+
+	test(`* | where`)
+	test(`* | where status`)
+	test(`* | where status = `)
+	test(`* | where status ! 200`)
+	test(`* | where status = 200 and`)
+	test(`* | where (status = 200`)
+	test(`* | where exists status`)
+	test(`* | where exists(status`)
+	test(`* | where stat* = 1`)
+	test(`* | where a = 1 b = 2`)
+}
+
+func TestParsePipeStats(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | stats count() by service`, `* | stats count() by service`)
+	test(`* | stats COUNT()`, `* | stats count()`)
+	test(`level:error | stats avg(duration),p99(duration) by service`, `level:error | stats avg(duration), p99(duration) by service`)
+	test(`* | stats sum(bytes), min(bytes), max(bytes), p50(bytes), p99.9(bytes)`, `* | stats sum(bytes), min(bytes), max(bytes), p50(bytes), p99.9(bytes)`)
+	test(`* | stats avg(request.duration_ms) by "k8s-pod"`, `* | stats avg(request.duration_ms) by k8s-pod`)
+	test(`* | stats count() by service,level`, `* | stats count() by service, level`)
+	// 'stats' after the pipes evaluated on the proxy is calculated on the fetched documents.
+	test(`* | where a = 1 | stats count()`, `* | where a = 1 | stats count()`)
+	test(`* | extract "took <ms>ms" | stats avg(ms)`, `* | extract "took <ms>ms" from message | stats avg(ms)`)
+
+	query, err := ParseSeqQL(`* | stats count(), p95(duration) by service`, nil)
+	require.NoError(t, err)
+	require.Equal(t, &PipeStats{
+		Funcs: []StatsFunc{
+			{Func: seq.AggFuncCount},
+			{Func: seq.AggFuncQuantile, Field: "duration", Quantile: 0.95},
+		},
+		By: []string{"service"},
+	}, query.Pipes[0])
+}
+
+func TestParsePipeStatsErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | stats`, `unknown function ""`)
+	test(`* | stats median(x)`, `unknown function "median"`)
+	test(`* | stats p101(x)`, `invalid percentile "p101"`)
+	test(`* | stats pnan(x)`, `invalid percentile "pnan"`)
+	test(`* | stats pinf(x)`, `invalid percentile "pinf"`)
+	test(`* | stats avg()`, `"avg" requires a field`)
+	test(`* | stats count(service)`, `'count' has no arguments`)
+	test(`* | stats avg(x`, `missing ')'`)
+	test(`* | stats avg(x*)`, `wildcards are not allowed`)
+	test(`* | stats count() by`, `parsing 'by' fields: empty list`)
+	test(`* | stats count() service`, `expected ',' or 'by'`)
+	test(`* | stats count() by a, b, c, d, e`, `grouping by more than 4 fields is not supported`)
+	test(`* | stats count() | fields a`, `'stats' pipe must be the last one`)
+	test(`* | stats count() | where a = 1`, `'stats' pipe must be the last one`)
+}
+
+func TestParsePipeSort(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | sort by status`, `* | sort by status`)
+	test(`* | sort by status asc`, `* | sort by status`)
+	test(`* | SORT BY status DESC,duration`, `* | sort by status desc, duration`)
+	test(`* | sort by "k8s-pod" desc | fields message`, `* | sort by k8s-pod desc | fields message`)
+	test(`* | sort by "desc" desc`, `* | sort by "desc" desc`)
+
+	query, err := ParseSeqQL(`* | sort by status desc, duration asc`, nil)
+	require.NoError(t, err)
+	require.Equal(t, &PipeSort{
+		Fields: []seq.SortField{
+			{Field: "status", Desc: true},
+			{Field: "duration"},
+		},
+	}, query.Pipes[0])
+}
+
+func TestParsePipeSortErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | sort`, `missing 'by' keyword`)
+	test(`* | sort status`, `missing 'by' keyword`)
+	test(`* | sort by`, `expected field name`)
+	test(`* | sort by status,`, `expected field name`)
+	test(`* | sort by status*`, `wildcards are not allowed`)
+	test(`* | sort by status up`, `expected ',', 'asc' or 'desc'`)
+	test(`* | fields status | sort by status`, `'sort' pipe must be the first one`)
+	test(`* | sort by status | sort by duration`, `'sort' pipe must be the first one`)
+	test(`* | stats count() | sort by status`, `'sort' pipe must be the first one`)
+}
+
+func TestParsePipeHeadTailDedup(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | head 50`, `* | head 50`)
+	test(`* | LIMIT 50`, `* | head 50`)
+	test(`* | tail 10`, `* | tail 10`)
+	test(`* | dedup trace_id`, `* | dedup trace_id`)
+	test(`* | dedup service,"error.fingerprint"`, `* | dedup service, error.fingerprint`)
+	test(`* | where level = "error" | dedup trace_id | head 5 | fields message`, `* | where level = "error" | dedup trace_id | head 5 | fields message`)
+	test(`* | head 100 | tail 10 | head 5`, `* | head 100 | tail 10 | head 5`)
+
+	query, err := ParseSeqQL(`* | dedup service, k8s_pod | tail 3`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Pipe{
+		&PipeDedup{Fields: []string{"service", "k8s_pod"}},
+		&PipeTail{Limit: 3},
+	}, query.Pipes)
+}
+
+func TestParsePipeHeadTailDedupErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | head`, `missing number of documents`)
+	test(`* | head 0`, `expected positive number of documents, got: "0"`)
+	test(`* | tail -1`, `expected positive number of documents`)
+	test(`* | limit "5"`, `expected positive number of documents`)
+	test(`* | head 5 10`, `unexpected token after number of documents: "10"`)
+	test(`* | tail 5 | tail 1`, `multiple 'tail' pipes is not allowed`)
+	test(`* | dedup`, `expected field name`)
+	test(`* | dedup a,`, `expected field name`)
+	test(`* | dedup a*`, `wildcards are not allowed`)
+	test(`* | dedup a b`, `expected ','`)
+}
+
+func TestParsePipeRenameEval(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | rename a as b`, `* | rename a as b`)
+	test(`* | rename "k8s.pod" AS pod,msg as "as"`, `* | rename k8s.pod as pod, msg as "as"`)
+	test(`* | eval latency_s = latency_ms / 1000, is_slow = latency_ms > 500`, `* | eval latency_s = latency_ms / 1000, is_slow = latency_ms > 500`)
+	test(`* | eval x = a+b * c`, `* | eval x = a + b * c`)
+	test(`* | eval x = (a + b) * c`, `* | eval x = (a + b) * c`)
+	test(`* | eval x = a - (b - c)`, `* | eval x = a - (b - c)`)
+	test(`* | eval x = (a - b) - c`, `* | eval x = a - b - c`)
+	test(`* | eval x = a % 2 = 0`, `* | eval x = a % 2 = 0`)
+	test(`* | eval x = -a * -2`, `* | eval x = -a * -2`)
+	test(`* | eval x = -(a + 1)`, `* | eval x = -(a + 1)`)
+	test(`* | eval x = k8s-pod`, `* | eval x = k8s-pod`)
+	test(`* | eval x = UPPER(lower(level)), y = substr(message, 0, 10)`, `* | eval x = upper(lower(level)), y = substr(message, 0, 10)`)
+	test(`* | eval x = concat(service, ":", code)`, `* | eval x = concat(service, ":", code)`)
+	test(`* | eval x = if(latency_ms > 500 and not exists(cached), "slow", "fast")`, `* | eval x = if(latency_ms > 500 and not exists(cached), "slow", "fast")`)
+	test(`* | eval x = (a > 1) = true`, `* | eval x = (a > 1) = true`)
+	test(`* | where latency_ms / 1000 > 1.5 | rename latency_ms as ms`, `* | where latency_ms / 1000 > 1.5 | rename latency_ms as ms`)
+	test(`* | where if(a = 1, b = 2, c = 3)`, `* | where if(a = 1, b = 2, c = 3)`)
+	test(`* | where lower(level) = "error"`, `* | where lower(level) = "error"`)
+	test(`* | eval x = 1.5e3 + .5 + 2.`, `* | eval x = 1.5e3 + .5 + 2.`)
+
+	// Only plain decimal numbers are literals, other names accepted by strconv.ParseFloat are fields.
+	for _, name := range []string{"inf", "Inf", "nan", "NaN", "infinity", "0x1p3", "1e", "1_000"} {
+		query, err := ParseSeqQL(`* | where `+name+` > 0`, nil)
+		require.NoError(t, err, name)
+		where := query.Pipes[0].(*PipeWhere)
+		require.Equal(t, &Expr{Kind: ExprField, Value: name}, where.Cond.Args[0], name)
+	}
+
+	query, err := ParseSeqQL(`* | rename a as b | eval c = a * 2`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Pipe{
+		&PipeRename{Fields: []RenameField{{From: "a", To: "b"}}},
+		&PipeEval{Fields: []EvalField{{Field: "c", Expr: &Expr{
+			Kind: ExprArith,
+			Op:   "*",
+			Args: []*Expr{{Kind: ExprField, Value: "a"}, {Kind: ExprNumber, Value: "2", Number: 2}},
+		}}}},
+	}, query.Pipes)
+}
+
+func TestParsePipeRenameEvalErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | rename`, `expected field name`)
+	test(`* | rename a`, `missing 'as' keyword after "a"`)
+	test(`* | rename a as`, `expected field name`)
+	test(`* | rename a as b c`, `expected ','`)
+	test(`* | rename a* as b`, `wildcards are not allowed`)
+	test(`* | eval`, `expected field name`)
+	test(`* | eval x`, `missing '=' after "x"`)
+	test(`* | eval x =`, `unexpected end of expression`)
+	test(`* | eval x = a +`, `unexpected end of expression`)
+	test(`* | eval x = a b`, `expected ',' or operator, got: "b"`)
+	test(`* | eval x = a*2`, `wildcards are not allowed`)
+	test(`* | eval x = (a + 1`, `missing ')'`)
+	test(`* | eval x = foo(a)`, `unknown function: "foo"`)
+	test(`* | eval x = lower(a, b)`, `wrong number of "lower" arguments: 2`)
+	test(`* | eval x = if(a, b)`, `wrong number of "if" arguments: 2`)
+	test(`* | eval x = concat()`, `wrong number of "concat" arguments: 0`)
+	test(`* | eval x = concat(a b)`, `expected ',' or ')' after "concat" argument, got: "b"`)
+	test(`* | where a + 1`, `expected condition, got: "a + 1"`)
+	test(`* | where lower(level)`, `expected condition`)
+	test(`* | where a = b = c`, `expected 'and', 'or', got: "="`)
+}
+
+func TestParsePipeExtract(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | extract "user=<user> took <dur>ms"`, `* | extract "user=<user> took <dur>ms" from message`)
+	test(`* | PARSE 'user=<user>' FROM payload.text`, `* | extract "user=<user>" from payload.text`)
+	test(`* | extract "a < b <_> <c>"`, `* | extract "a < b <_> <c>" from message`)
+	test(`* | extract re("user=(?P<user>\\w+)") from "from"`, `* | extract re("user=(?P<user>\\w+)") from from`)
+	test("* | extract re(`(?P<k>\\w*)=(?P<v>.*)`)", `* | extract re("(?P<k>\\w\*)=(?P<v>.\*)") from message`)
+	test(`* | extract "took <ms>ms" | where ms > 100 | fields ms`, `* | extract "took <ms>ms" from message | where ms > 100 | fields ms`)
+
+	query, err := ParseSeqQL(`* | extract "<_>user=<user> took <dur>"`, nil)
+	require.NoError(t, err)
+	e := query.Pipes[0].(*PipeExtract)
+	require.Equal(t, []string{"", "user=", " took ", ""}, e.Texts)
+	require.Equal(t, []string{"", "user", "dur"}, e.Fields)
+	require.Equal(t, "message", e.From)
+}
+
+func TestPipeExtractMatch(t *testing.T) {
+	test := func(q, text string, expected []string) {
+		t.Helper()
+		query, err := ParseSeqQL("* | extract "+q, nil)
+		require.NoError(t, err)
+		values, ok := query.Pipes[0].(*PipeExtract).Match(text, nil)
+		require.Equal(t, expected != nil, ok)
+		if ok {
+			require.Equal(t, expected, values)
+		}
+	}
+
+	test(`"user=<user> took <dur>ms"`, `request user=bob took 15ms, ok`, []string{"bob", "15"})
+	test(`"user=<user> took <dur>ms"`, `user=bob took 15s`, nil)
+	test(`"user=<user> took <dur>ms"`, `took 15ms`, nil)
+	test(`"<level>: <msg>"`, `error: disk is full: /var`, []string{"error", "disk is full: /var"})
+	test(`"<_> id=<id>"`, `x id=1 id=2`, []string{"x", "1 id=2"})
+	test(`"[<ts>]"`, `[] empty`, []string{""})
+	test(`re("user=(?P<user>[a-z]+)( took (?P<dur>\d+)ms)?")`, `user=bob`, []string{"bob", "", ""})
+	test(`re("user=(?P<user>[a-z]+)( took (?P<dur>\d+)ms)?")`, `user=bob took 7ms`, []string{"bob", " took 7ms", "7"})
+	test(`re("^user=(?P<user>[a-z]+)")`, `id=1 user=bob`, nil)
+}
+
+func TestParsePipeExtractErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | extract`, `expected quoted pattern`)
+	test(`* | extract user`, `expected quoted pattern, got: "user"`)
+	test(`* | extract "user=<>"`, `pattern has no placeholders`)
+	test(`* | extract "user=<_>"`, `pattern has no placeholders`)
+	test(`* | extract "<a><b>"`, `placeholders must be separated by text`)
+	test(`* | extract "<a>" from`, `expected field name`)
+	test(`* | extract "<a>" message`, `expected 'from', got: "message"`)
+	test(`* | extract re("(")`, `missing closing )`)
+	test(`* | extract re("(\\w+)")`, `regular expression has no named groups`)
+	test(`* | extract re "<a>"`, `expected '('`)
+	test(`* | extract re("(?P<a>.)"`, `expected ')'`)
+}
+
+func TestParsePipeTop(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | top 5 service`, `* | top 5 service`)
+	test(`* | top service`, `* | top 10 service`)
+	test(`* | RARE 3 "error.code"`, `* | rare 3 error.code`)
+	test(`level:error | where code > 500 | rare code`, `level:error | where code > 500 | rare 10 code`)
+
+	query, err := ParseSeqQL(`* | rare 3 error_code`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Pipe{&PipeTop{Limit: 3, Field: "error_code", Rare: true}}, query.Pipes)
+	require.Equal(t, &PipeStats{
+		Funcs: []StatsFunc{{Func: seq.AggFuncCount}},
+		By:    []string{"error_code"},
+	}, query.Pipes[0].(*PipeTop).Stats())
+}
+
+func TestParsePipeTopErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | top`, `expected field name`)
+	test(`* | top 10`, `expected field name`)
+	test(`* | top 0 service`, `expected positive number of values, got: "0"`)
+	test(`* | rare -1 service`, `unexpected token after field name: "service"`)
+	test(`* | top 10 service level`, `unexpected token after field name: "level"`)
+	test(`* | top service*`, `wildcards are not allowed`)
+	test(`* | top service | head 5`, `'top' pipe must be the last one`)
+	test(`* | rare service | fields a`, `'rare' pipe must be the last one`)
+}
+
+func TestParsePipeTimechart(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		query, err := ParseSeqQL(q, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, query.SeqQLString())
+	}
+
+	test(`* | timechart span=1m count() by level`, `* | timechart span=1m count() by level`)
+	test(`* | timechart span=1m count() by service, level`, `* | timechart span=1m count() by service, level`)
+	test(`* | TIMECHART span = 300s avg(duration), p99(duration) by service`, `* | timechart span=5m avg(duration), p99(duration) by service`)
+	test(`* | timechart span=1500ms max(duration)`, `* | timechart span=1500ms max(duration)`)
+	test(`* | timechart span=1d count()`, `* | timechart span=1d count()`)
+	test(`* | where level = "error" | timechart span=1h count()`, `* | where level = "error" | timechart span=1h count()`)
+
+	query, err := ParseSeqQL(`* | timechart span=5m avg(duration) by service`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Pipe{&PipeTimechart{
+		Span:  5 * time.Minute,
+		Funcs: []StatsFunc{{Func: seq.AggFuncAvg, Field: "duration"}},
+		By:    []string{"service"},
+	}}, query.Pipes)
+}
+
+func TestParsePipeTimechartErrors(t *testing.T) {
+	test := func(q, expected string) {
+		t.Helper()
+		_, err := ParseSeqQL(q, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), expected)
+	}
+
+	test(`* | timechart count()`, `missing 'span', expected time bin like 'span=1m', got: "count"`)
+	test(`* | timechart span 1m count()`, `missing '=' after 'span'`)
+	test(`* | timechart span=0s count()`, `expected positive duration like '1m' or '30s', got: "0s"`)
+	test(`* | timechart span=minute count()`, `expected positive duration`)
+	test(`* | timechart span=1m`, `unknown function ""`)
+	test(`* | timechart span=1m count() by a, b, c, d, e`, `grouping by more than 4 fields is not supported`)
+	test(`* | timechart span=1m count() | head 5`, `'timechart' pipe must be the last one`)
+}
+
+func TestFormatSpan(t *testing.T) {
+	require.Equal(t, "2w", FormatSpan(14*24*time.Hour))
+	require.Equal(t, "36h", FormatSpan(36*time.Hour))
+	require.Equal(t, "90s", FormatSpan(90*time.Second))
+	require.Equal(t, "10ms", FormatSpan(10*time.Millisecond))
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+// timeField is the name of the field which filters documents by their timestamps, e.g. `_time:[now-15m, now]`.
+const timeField = "_time"
+
+// TimeRange is the range of document timestamps set by `_time` filters of the query, both borders are included.
+// Seq-proxy intersects it with the time range of the request, stores don't evaluate `_time` filters.
+type TimeRange struct {
+	From seq.MID
+	To   seq.MID
+}
+
+// Intersect returns the intersection of the range with the given one, which is empty if from is greater than to.
+func (r *TimeRange) Intersect(from, to seq.MID) (seq.MID, seq.MID) {
+	if r == nil {
+		return from, to
+	}
+	return max(from, r.From), min(to, r.To)
+}
+
+func (r *TimeRange) DumpSeqQL(b *strings.Builder) {
+	b.WriteString(timeField + ":[")
+	dumpTimeBorder(b, r.From, 0)
+	b.WriteString(", ")
+	dumpTimeBorder(b, r.To, math.MaxUint64)
+	b.WriteString("]")
+}
+
+func dumpTimeBorder(b *strings.Builder, mid, unbounded seq.MID) {
+	term := Term{Kind: TermSymbol, Data: "*"}
+	if mid != unbounded {
+		term = Term{Kind: TermText, Data: mid.Time().UTC().Format(time.RFC3339Nano)}
+	}
+	term.DumpSeqQL(b)
+}
+
+// parseTimeFilter parses filter of document timestamps.
+// Borders are dates or relative dates like "now-1h", which are resolved once per query.
+// Example queries:
+//
+//	_time:[now-15m, now]
+//	_time:>now-1d
+//	_time:<="2024-01-01T00:00:00Z"
+func parseTimeFilter(lex *lexer) (*ASTNode, error) {
+	var r *Range
+	switch {
+	case lex.IsKeywords("[", "("):
+		var err error
+		r, err = parseSeqQLTokenRange(timeField, lex, true)
+		if err != nil {
+			return nil, fmt.Errorf("parsing range for field %q: %s", timeField, err)
+		}
+	case lex.IsKeywords("<", ">"):
+		greater := lex.IsKeyword(">")
+		lex.Next()
+		include := false
+		if lex.IsKeyword("=") && !lex.SpaceSkipped {
+			include = true
+			lex.Next()
+		}
+		var border Term
+		if err := parseRangeTerm(&border, lex, true); err != nil {
+			return nil, fmt.Errorf("parsing filter value for field %q: %s", timeField, err)
+		}
+		unbounded := Term{Kind: TermSymbol, Data: "*"}
+		if greater {
+			r = &Range{Field: timeField, From: border, To: unbounded, IncludeFrom: include, IncludeTo: true}
+		} else {
+			r = &Range{Field: timeField, From: unbounded, To: border, IncludeFrom: true, IncludeTo: include}
+		}
+	default:
+		return nil, fmt.Errorf("expected range like '[now-1h, now]' or comparison like '>now-1h' for field %q, got: %q",
+			timeField, lex.Token)
+	}
+	if err := setNumericRange(r, seq.TokenizerTypeDate); err != nil {
+		return nil, fmt.Errorf("parsing range for field %q: %s", timeField, err)
+	}
+	return newTokenNode(r), nil
+}
+
+// extractTimeRange removes `_time` filters which are joined with the rest of the filter by 'and'
+// and returns the intersection of their ranges. Relative dates must be resolved already.
+func extractTimeRange(root *ASTNode) (*ASTNode, *TimeRange, error) {
+	var tr *TimeRange
+	root = extractTimeFilters(root, &tr)
+	if root == nil {
+		// Query consists of `_time` filters only.
+		root = newMatchAllNode()
+	}
+	if hasTimeFilter(root) {
+		return nil, nil, fmt.Errorf("%q filter can be joined with other filters only by 'and'", timeField)
+	}
+	return root, tr, nil
+}
+
+func extractTimeFilters(node *ASTNode, tr **TimeRange) *ASTNode {
+	if r, ok := node.Value.(*Range); ok && r.Field == timeField {
+		from, to := timeRangeBorders(r)
+		if *tr == nil {
+			*tr = &TimeRange{From: from, To: to}
+		} else {
+			(*tr).From, (*tr).To = (*tr).Intersect(from, to)
+		}
+		return nil
+	}
+	if l, ok := node.Value.(*Logical); !ok || l.Operator != LogicalAnd {
+		return node
+	}
+	left := extractTimeFilters(node.Children[0], tr)
+	right := extractTimeFilters(node.Children[1], tr)
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	}
+	node.Children[0], node.Children[1] = left, right
+	return node
+}
+
+func timeRangeBorders(r *Range) (from, to seq.MID) {
+	from, to = 0, math.MaxUint64
+	if !r.From.IsWildcard() {
+		t, _ := seq.ParseDate(r.From.Data)
+		from = seq.MID(t.UnixMilli())
+		if !r.IncludeFrom {
+			from++
+		}
+	}
+	if !r.To.IsWildcard() {
+		t, _ := seq.ParseDate(r.To.Data)
+		to = seq.MID(t.UnixMilli())
+		if !r.IncludeTo {
+			to--
+		}
+	}
+	return from, to
+}
+
+func hasTimeFilter(node *ASTNode) bool {
+	if r, ok := node.Value.(*Range); ok && r.Field == timeField {
+		return true
+	}
+	for _, child := range node.Children {
+		if hasTimeFilter(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMatchAll returns true if the node is the filter of the query `*`.
+func isMatchAll(node *ASTNode) bool {
+	l, ok := node.Value.(*Literal)
+	return ok && l.Field == seq.TokenAll && len(l.Terms) == 1 && l.Terms[0].IsWildcard()
+}
+
+func newMatchAllNode() *ASTNode {
+	return &ASTNode{
+		Value: &Literal{
+			Field: seq.TokenAll,
+			Terms: []Term{{Kind: TermSymbol, Data: "*"}},
+		},
+	}
+}
@@ -61,8 +61,9 @@ func (b *baseTokenBuilder) finishToken() {
 	b.finishTextTerm()
 	if len(b.terms) != 0 {
 		b.tokens = append(b.tokens, &Literal{
-			Field: b.fieldName,
-			Terms: append(make([]Term, 0, len(b.terms)), b.terms...),
+			Field:         b.fieldName,
+			Terms:         append(make([]Term, 0, len(b.terms)), b.terms...),
+			CaseSensitive: b.caseSensitive,
 		})
 		b.terms = b.terms[:0]
 	}
@@ -7,12 +7,17 @@ import (
 	"strings"
 
 	"go4.org/netipx"
+
+	"github.com/ozontech/seq-db/seq"
 )
 
 type IPRange struct {
 	Field string
 	From  Term
 	To    Term
+	// IndexType is seq.TokenizerTypeIP for fields of ip type which tokens are binary encoded.
+	// For keyword fields it is seq.TokenizerTypeNoop.
+	IndexType seq.TokenizerType
 }
 
 func (n *IPRange) Dump(builder *strings.Builder) {
@@ -28,6 +33,12 @@ func (n *IPRange) Dump(builder *strings.Builder) {
 
 func (n *IPRange) DumpSeqQL(b *strings.Builder) {
 	b.WriteString(quoteTokenIfNeeded(n.Field))
+	if n.IndexType == seq.TokenizerTypeIP && n.From == n.To {
+		// Single address of ip field. Exact match of keyword field is a literal, so the range is kept as is.
+		b.WriteString(`:`)
+		n.From.DumpSeqQL(b)
+		return
+	}
 	b.WriteString(`:ip_range(`)
 
 	n.From.DumpSeqQL(b)
@@ -87,8 +98,9 @@ func parseFilterIPRange(lex *lexer, fieldName string) (*IPRange, error) {
 			return nil, err
 		}
 
-		if from.Compare(to) >= 0 {
-			return nil, fmt.Errorf("first ip %q is greater or equal than second ip %q", from, to)
+		// Equal addresses are allowed, like in 'cidr' filter with /32 or /128 prefix.
+		if from.Compare(to) > 0 {
+			return nil, fmt.Errorf("first ip %q is greater than second ip %q", from, to)
 		}
 
 		r = netipx.IPRangeFrom(from, to)
@@ -106,3 +118,65 @@ func parseFilterIPRange(lex *lexer, fieldName string) (*IPRange, error) {
 		To:    newTextTerm(r.To().String()),
 	}, nil
 }
+
+// parseFilterCIDR parses 'cidr' filter. It is a shorthand for 'ip_range' with ip address in CIDR notation.
+// Example queries:
+//
+//	client_ip:cidr(10.0.0.0/8)
+//	client_ip:cidr("2001:db8::/32")
+func parseFilterCIDR(lex *lexer, fieldName string) (*IPRange, error) {
+	if !lex.IsKeyword("(") {
+		return nil, fmt.Errorf("expected '(', got %q", lex.Token)
+	}
+	lex.Next()
+
+	if lex.IsKeyword(")") {
+		return nil, errors.New("empty 'cidr' filter")
+	}
+
+	tok, err := parseCompositeToken(lex, '/')
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := netip.ParsePrefix(tok)
+	if err != nil {
+		return nil, err
+	}
+	r := netipx.RangeOfPrefix(prefix)
+
+	if !lex.IsKeyword(")") {
+		return nil, fmt.Errorf("expected ')', got %q", lex.Token)
+	}
+
+	lex.Next()
+
+	return &IPRange{
+		Field: fieldName,
+		From:  newTextTerm(r.From().String()),
+		To:    newTextTerm(r.To().String()),
+	}, nil
+}
+
+// newIPFilter creates filter that matches single address of ip field.
+// Different spellings of the same address are stored as the same binary token,
+// so exact match is represented as a range with equal borders.
+func newIPFilter(fieldName string, terms []Term) (Token, error) {
+	if len(terms) == 1 && terms[0].IsWildcard() {
+		// Any value.
+		return &Literal{Field: fieldName, Terms: terms}, nil
+	}
+	if len(terms) != 1 || terms[0].Kind != TermText {
+		return nil, errors.New("wildcards are not supported for ip fields, use 'cidr' or 'ip_range' instead")
+	}
+	addr, ok := seq.ParseIP(terms[0].Data)
+	if !ok {
+		return nil, fmt.Errorf("invalid ip value %q", terms[0].Data)
+	}
+	return &IPRange{
+		Field:     fieldName,
+		From:      newTextTerm(addr.String()),
+		To:        newTextTerm(addr.String()),
+		IndexType: seq.TokenizerTypeIP,
+	}, nil
+}
@@ -11,6 +11,8 @@ import (
 type Literal struct {
 	Field string
 	Terms []Term
+	// CaseSensitive is false if the terms are lowercased because tokens of the field are stored in lower case.
+	CaseSensitive bool
 }
 
 func (n *Literal) Dump(builder *strings.Builder) {
@@ -122,6 +124,12 @@ func GetField(token Token) string {
 		return t.Field
 	case *IPRange:
 		return t.Field
+	case *Substring:
+		return t.Field
+	case *Phrase:
+		return t.Field
+	case *Regexp:
+		return t.Field
 	}
 	panic(fmt.Sprintf("unknown token type: %T", token))
 }
@@ -132,6 +140,8 @@ func GetHint(token Token) string {
 		if t.Terms[0].Kind == TermText {
 			return t.Terms[0].Data
 		}
+	case *Regexp:
+		return t.Prefix()
 	default:
 	}
 	return ""
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"unicode"
 
-	"github.com/ozontech/seq-db/config"
 	"github.com/ozontech/seq-db/seq"
 )
 
@@ -212,20 +211,23 @@ func (tp *tokenParser) parseRange(r *Range) error {
 	return nil
 }
 
-func (tp *tokenParser) parseLiteral(fieldName string, indexType seq.TokenizerType) ([]Token, error) {
-	caseSensitive := config.CaseSensitive
-	if fieldName == seq.TokenExists {
-		caseSensitive = true
-	}
-
+func (tp *tokenParser) parseLiteral(fieldName string, indexType seq.TokenizerType, caseSensitive bool) ([]Token, error) {
 	if tp.eof() {
 		return nil, tp.errorEOF("search term")
 	}
 	if tp.cur() == '[' || tp.cur() == '{' {
+		if indexType == seq.TokenizerTypeIP {
+			return nil, tp.errorUnexpected(tp.pos, "range for ip field")
+		}
 		r := &Range{Field: fieldName}
 		if err := tp.parseRange(r); err != nil {
 			return nil, err
 		}
+		if seq.IsNumericType(indexType) {
+			if err := setNumericRange(r, indexType); err != nil {
+				return nil, err
+			}
+		}
 		return []Token{r}, nil
 	}
 	var lb tokenBuilder
@@ -247,7 +249,7 @@ func (tp *tokenParser) parseLiteral(fieldName string, indexType seq.TokenizerTyp
 				return false
 			},
 		}
-	case seq.TokenizerTypeKeyword, seq.TokenizerTypePath:
+	case seq.TokenizerTypeKeyword, seq.TokenizerTypePath, seq.TokenizerTypeNgram, seq.TokenizerTypeLong, seq.TokenizerTypeDouble, seq.TokenizerTypeDate, seq.TokenizerTypeIP:
 		lb = &keywordTokenBuilder{
 			baseTokenBuilder: baseBuilder,
 		}
@@ -261,15 +263,15 @@ func (tp *tokenParser) parseLiteral(fieldName string, indexType seq.TokenizerTyp
 		}
 		tokens := lb.getTokens()
 		if len(tokens) == 0 {
-			return []Token{&Literal{
+			tokens = []Token{&Literal{
 				Field: fieldName,
 				Terms: []Term{{
 					Kind: TermText,
 					Data: "",
 				}},
-			}}, nil
+			}}
 		}
-		return tokens, nil
+		return binaryTokens(fieldName, indexType, tokens)
 	}
 	if err := tp.parseTerms(lb); err != nil {
 		return nil, err
@@ -281,10 +283,48 @@ func (tp *tokenParser) parseLiteral(fieldName string, indexType seq.TokenizerTyp
 		}
 		return nil, tp.errorUnexpected(pos, `sequence "%s" instead of token query term`, string(tp.data[pos:tp.pos]))
 	}
+	return binaryTokens(fieldName, indexType, tokens)
+}
+
+// binaryTokens converts literals of numeric and ip fields to filters over binary tokens.
+// Tokens of other fields are returned as is.
+func binaryTokens(fieldName string, indexType seq.TokenizerType, tokens []Token) ([]Token, error) {
+	if !seq.IsNumericType(indexType) && indexType != seq.TokenizerTypeIP {
+		return tokens, nil
+	}
+	for i, token := range tokens {
+		literal, ok := token.(*Literal)
+		if !ok {
+			continue
+		}
+		var (
+			filter Token
+			err    error
+		)
+		if indexType == seq.TokenizerTypeIP {
+			filter, err = newIPFilter(fieldName, literal.Terms)
+		} else {
+			filter, err = newNumericFilter(fieldName, indexType, literal.Terms)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens[i] = filter
+	}
 	return tokens, nil
 }
 
-func (tp *tokenParser) parseTokenQuery(fieldName string, indexType seq.TokenizerType) ([]Token, error) {
+// NewBinaryFilter converts literal to filter over binary tokens of numeric or ip field.
+// It is used to search fractions which index type of the field differs from the current mapping.
+func NewBinaryFilter(literal *Literal, indexType seq.TokenizerType) (Token, error) {
+	tokens, err := binaryTokens(literal.Field, indexType, []Token{literal})
+	if err != nil {
+		return nil, err
+	}
+	return tokens[0], nil
+}
+
+func (tp *tokenParser) parseTokenQuery(fieldName string, indexType seq.TokenizerType, caseSensitive bool) ([]Token, error) {
 	if tp.eof() {
 		return nil, tp.errorEOF(`field name separator ':'`)
 	}
@@ -293,5 +333,5 @@ func (tp *tokenParser) parseTokenQuery(fieldName string, indexType seq.Tokenizer
 	}
 	tp.pos++
 	tp.skipSpaces()
-	return tp.parseLiteral(fieldName, indexType)
+	return tp.parseLiteral(fieldName, indexType, caseSensitive)
 }
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ozontech/seq-db/analyzer"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// Phrase matches values of text field which contain Words next to each other in the given order.
+// It is evaluated as a conjunction of the words, then candidate documents are verified.
+type Phrase struct {
+	Field string
+	// Source is the document field which values are verified (see Substring.Source).
+	Source        string
+	Words         []string
+	CaseSensitive bool
+	// FoldCase is true if Words are lowercased, but tokens of the field are stored as is,
+	// e.g. in fractions indexed before the field became case insensitive. Words are searched ignoring case then.
+	FoldCase bool
+	// Analyzer of the field is applied to the words of verified values, nil if the field has none.
+	Analyzer *analyzer.Analyzer
+}
+
+func (n *Phrase) Dump(builder *strings.Builder) {
+	builder.WriteString(quoteTokenIfNeeded(n.Field))
+	builder.WriteString(`:phrase(`)
+	builder.WriteString(quote(strings.Join(n.Words, " ")))
+	builder.WriteString(`)`)
+}
+
+func (n *Phrase) DumpSeqQL(b *strings.Builder) {
+	b.WriteString(quoteTokenIfNeeded(n.Field))
+	b.WriteString(`:phrase(`)
+	b.WriteString(quote(strings.Join(n.Words, " ")))
+	b.WriteString(`)`)
+}
+
+// parseFilterPhrase parses 'phrase' filter.
+// Phrase is split into words the same way as text field values.
+// Example queries:
+//
+//	message:phrase("connection reset by peer")
+func parseFilterPhrase(lex *lexer, fieldName string, mapping *seq.Mapping, caseSensitive bool) (*ASTNode, error) {
+	if !lex.IsKeyword("(") {
+		return nil, fmt.Errorf("expected '(', got %q", lex.Token)
+	}
+	lex.Next()
+
+	if lex.IsKeyword(")") {
+		return nil, fmt.Errorf("empty 'phrase' filter")
+	}
+
+	value, err := parseCompositeToken(lex)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lex.IsKeyword(")") {
+		return nil, fmt.Errorf("expected ')', got %q", lex.Token)
+	}
+	lex.Next()
+
+	a := fieldAnalyzer(mapping, fieldName)
+	tokens, err := parseSeqQLText(fieldName, value, caseSensitive, a)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty 'phrase' filter")
+	}
+
+	words := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		literal := token.(*Literal)
+		if len(literal.Terms) != 1 || literal.Terms[0].Kind != TermText {
+			return nil, fmt.Errorf("wildcards are not supported in 'phrase' filter")
+		}
+		if literal.Terms[0].Data != "" {
+			words = append(words, literal.Terms[0].Data)
+		}
+	}
+
+	switch len(words) {
+	case 0:
+		return nil, fmt.Errorf("empty 'phrase' filter")
+	case 1:
+		// Single word doesn't need verification.
+		return newTokenNode(&Literal{
+			Field:         fieldName,
+			Terms:         []Term{newTextTerm(words[0])},
+			CaseSensitive: caseSensitive,
+		}), nil
+	}
+
+	return newTokenNode(&Phrase{
+		Field:         fieldName,
+		Source:        sourceField(mapping, fieldName),
+		Words:         words,
+		CaseSensitive: caseSensitive,
+		Analyzer:      a,
+	}), nil
+}
@@ -3,6 +3,9 @@ package parser
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/ozontech/seq-db/seq"
 )
 
 type Range struct {
@@ -11,6 +14,9 @@ type Range struct {
 	To          Term
 	IncludeFrom bool
 	IncludeTo   bool
+	// IndexType is set for numeric and date fields (see seq.IsNumericType) which tokens are binary encoded.
+	// For other fields it is seq.TokenizerTypeNoop.
+	IndexType seq.TokenizerType
 }
 
 func (n *Range) Dump(builder *strings.Builder) {
@@ -83,7 +89,8 @@ func parseSeqQLTokenRange(field string, lex *lexer, sensitive bool) (*Range, err
 
 func parseRangeTerm(term *Term, lex *lexer, sensitive bool) error {
 	term.Kind = TermText
-	value, err := parseCompositeToken(lex)
+	// Allow unquoted dates like 2024-01-01T00:00:00+03:00.
+	value, err := parseCompositeToken(lex, ':', '+')
 	if err != nil {
 		return err
 	}
@@ -104,3 +111,70 @@ func parseRangeTerm(term *Term, lex *lexer, sensitive bool) error {
 	}
 	return nil
 }
+
+// setNumericRange validates range borders of numeric field and marks range as numeric.
+func setNumericRange(r *Range, t seq.TokenizerType) error {
+	for _, term := range []Term{r.From, r.To} {
+		if term.IsWildcard() {
+			continue
+		}
+		if !isValidNumber(t, term.Data) {
+			return fmt.Errorf("invalid %s value %q", seq.TokenTypesToNames[t], term.Data)
+		}
+	}
+	r.IndexType = t
+	return nil
+}
+
+func isValidNumber(t seq.TokenizerType, s string) bool {
+	if t == seq.TokenizerTypeDate {
+		// Relative dates are resolved after parsing (see resolveRelativeDates).
+		_, ok := seq.ParseDateExpr(s, time.Now())
+		return ok
+	}
+	_, ok := seq.AppendNumeric(nil, t, s)
+	return ok
+}
+
+// newNumericFilter creates filter that matches single value of numeric field.
+// Since numeric tokens are binary encoded, exact match is represented as a range with equal borders.
+func newNumericFilter(fieldName string, t seq.TokenizerType, terms []Term) (Token, error) {
+	if len(terms) == 1 && terms[0].IsWildcard() {
+		// Any value.
+		return &Literal{Field: fieldName, Terms: terms}, nil
+	}
+	if len(terms) != 1 || terms[0].Kind != TermText {
+		return nil, fmt.Errorf("wildcards are not supported for %s fields", seq.TokenTypesToNames[t])
+	}
+	r := &Range{
+		Field:       fieldName,
+		From:        terms[0],
+		To:          terms[0],
+		IncludeFrom: true,
+		IncludeTo:   true,
+	}
+	if err := setNumericRange(r, t); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// resolveRelativeDates replaces relative borders of date ranges like "now-1h" with absolute dates.
+// Borders are resolved once per query, so all fractions are searched with the same range.
+func resolveRelativeDates(root *ASTNode, now time.Time) {
+	for _, child := range root.Children {
+		resolveRelativeDates(child, now)
+	}
+	r, ok := root.Value.(*Range)
+	if !ok || r.IndexType != seq.TokenizerTypeDate {
+		return
+	}
+	for _, term := range []*Term{&r.From, &r.To} {
+		if term.IsWildcard() || !strings.HasPrefix(term.Data, "now") {
+			continue
+		}
+		if date, ok := seq.ParseDateExpr(term.Data, now); ok {
+			term.Data = date.UTC().Format(time.RFC3339Nano)
+		}
+	}
+}
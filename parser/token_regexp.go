@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Regexp matches field tokens with RE2 regular expression.
+// The whole token must match the expression, e.g. `re("err.*")` matches "error", but not "stderr".
+type Regexp struct {
+	Field   string
+	Pattern string
+	// CaseSensitive is false if tokens of the field are stored in lower case,
+	// so the expression is matched ignoring case.
+	CaseSensitive bool
+}
+
+func (n *Regexp) Dump(builder *strings.Builder) {
+	builder.WriteString(quoteTokenIfNeeded(n.Field))
+	builder.WriteString(`:re(`)
+	builder.WriteString(strconv.Quote(n.Pattern))
+	builder.WriteString(`)`)
+}
+
+func (n *Regexp) DumpSeqQL(b *strings.Builder) {
+	b.WriteString(quoteTokenIfNeeded(n.Field))
+	b.WriteString(`:re(`)
+	// Wildcards are not special in expressions, so they aren't escaped.
+	b.WriteString(strconv.Quote(n.Pattern))
+	b.WriteString(`)`)
+}
+
+// Compile returns regular expression that matches whole tokens.
+func (n *Regexp) Compile() (*regexp.Regexp, error) {
+	flags := ""
+	if !n.CaseSensitive {
+		flags = "(?i)"
+	}
+	return regexp.Compile(flags + anchorRegexp(n.Pattern))
+}
+
+// Prefix returns literal prefix that all matching tokens must start with.
+// It is used to narrow the range of tokens checked by the expression.
+func (n *Regexp) Prefix() string {
+	// Case insensitive expression has no literal prefix, so it is extracted from case sensitive one.
+	re, err := regexp.Compile(anchorRegexp(n.Pattern))
+	if err != nil {
+		return ""
+	}
+	prefix, _ := re.LiteralPrefix()
+	if !n.CaseSensitive {
+		prefix = strings.ToLower(prefix)
+	}
+	return prefix
+}
+
+// IgnoreCase returns expression which matches the same tokens ignoring case.
+// It is used to search lowercased values among tokens stored as is,
+// e.g. in fractions indexed before the field became case insensitive.
+// Case insensitive expression has no literal prefix, so all tokens of the field are checked.
+func (n *Regexp) IgnoreCase() *Regexp {
+	return &Regexp{
+		Field:         n.Field,
+		Pattern:       `(?i:` + n.Pattern + `)`,
+		CaseSensitive: true,
+	}
+}
+
+// IgnoreCaseRegexp returns expression which matches the same tokens as the literal ignoring case (see Regexp.IgnoreCase).
+func (n *Literal) IgnoreCaseRegexp() *Regexp {
+	var pattern strings.Builder
+	for _, term := range n.Terms {
+		if term.IsWildcard() {
+			pattern.WriteString(`.*`)
+			continue
+		}
+		pattern.WriteString(regexp.QuoteMeta(term.Data))
+	}
+	r := &Regexp{Field: n.Field, Pattern: pattern.String()}
+	return r.IgnoreCase()
+}
+
+func anchorRegexp(pattern string) string {
+	return `^(?:` + pattern + `)$`
+}
+
+// parseFilterRegexp parses 're' filter.
+// Escape sequences are processed in quoted strings, so it is convenient to use raw strings for expressions.
+// Example queries:
+//
+//	trace_id:re("ab[0-9]+-\\d{3}")
+//	k8s_pod:re(`(api|gateway)-\d+`)
+func parseFilterRegexp(lex *lexer, fieldName string, caseSensitive bool) (*ASTNode, error) {
+	if !lex.IsKeyword("(") {
+		return nil, fmt.Errorf("expected '(', got %q", lex.Token)
+	}
+	lex.Next()
+
+	if lex.IsKeyword(")") {
+		return nil, errors.New("empty 're' filter")
+	}
+
+	pattern, err := parseCompositeTokenReplaceWildcards(lex)
+	if err != nil {
+		return nil, err
+	}
+
+	if !lex.IsKeyword(")") {
+		return nil, fmt.Errorf("expected ')', got %q", lex.Token)
+	}
+	lex.Next()
+
+	r := &Regexp{
+		Field:         fieldName,
+		Pattern:       pattern,
+		CaseSensitive: caseSensitive,
+	}
+	if _, err := r.Compile(); err != nil {
+		return nil, err
+	}
+	return newTokenNode(r), nil
+}
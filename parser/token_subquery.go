@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+const (
+	// defaultSubqueryLimit is the number of values selected by subquery if the limit is not set.
+	defaultSubqueryLimit = 1000
+	// maxSubqueryLimit bounds the number of values substituted into 'in' filter.
+	maxSubqueryLimit = 10000
+)
+
+// Subquery matches the values of Field which are selected from the documents found by the inner query,
+// e.g. `trace_id:in(select trace_id where level:error | limit 1000)`.
+// Stores don't evaluate it: seq-proxy runs the inner query as 'unique' aggregation of Select field
+// and substitutes the found values into 'in' filter with ReplaceSubqueries.
+type Subquery struct {
+	Field string
+	// Select is the field which values are selected by the inner query.
+	Select string
+	// Query is the filter of the inner query as it is written in the query.
+	Query string
+	// Root is the parsed filter of the inner query.
+	Root *ASTNode
+	// Limit is the maximum number of the selected values.
+	Limit int
+
+	// start and end are the bounds of the whole filter in the query.
+	// They are lengths of the query tails like lexer.tail, so the position of start is len(query)-start.
+	start, end int
+}
+
+func (n *Subquery) Dump(builder *strings.Builder) {
+	builder.WriteString(quoteTokenIfNeeded(n.Field))
+	builder.WriteString(`:in(select `)
+	builder.WriteString(quoteTokenIfNeeded(n.Select))
+	builder.WriteString(` where `)
+	n.Root.Dump(builder)
+	builder.WriteString(` | limit `)
+	builder.WriteString(strconv.Itoa(n.Limit))
+	builder.WriteString(`)`)
+}
+
+func (n *Subquery) DumpSeqQL(b *strings.Builder) {
+	b.WriteString(quoteTokenIfNeeded(n.Field))
+	b.WriteString(`:in(select `)
+	b.WriteString(quoteTokenIfNeeded(n.Select))
+	b.WriteString(` where `)
+	n.Root.DumpSeqQL(b)
+	b.WriteString(` | limit `)
+	b.WriteString(strconv.Itoa(n.Limit))
+	b.WriteString(`)`)
+}
+
+// ReplaceSubqueries replaces subqueries of the query with 'in' filters of the selected values.
+// Subqueries must be parsed from the query, values[i] are the values selected by subqueries[i].
+// Subquery without values is replaced with the filter which matches nothing, since 'in' filter can't be empty.
+func ReplaceSubqueries(query string, subqueries []*Subquery, values [][]string) string {
+	idx := make([]int, len(subqueries))
+	for i := range idx {
+		idx[i] = i
+	}
+	// Replace from the beginning of the query, since positions are counted from the end of the query
+	// and don't change for the rest of the subqueries.
+	slices.SortFunc(idx, func(a, b int) int {
+		return subqueries[b].start - subqueries[a].start
+	})
+
+	for _, i := range idx {
+		s := subqueries[i]
+		b := &strings.Builder{}
+		b.WriteString(query[:len(query)-s.start])
+		if len(values[i]) == 0 {
+			b.WriteString("(not " + seq.TokenAll + ":*)")
+		} else {
+			b.WriteString(quoteTokenIfNeeded(s.Field))
+			b.WriteString(":in(")
+			for j, v := range values[i] {
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				b.WriteString(quote(v))
+			}
+			b.WriteString(")")
+		}
+		b.WriteString(query[len(query)-s.end:])
+		query = b.String()
+	}
+	return query
+}
+
+// collectSubqueries returns subqueries of the filter, the ones of the inner queries are not included.
+func collectSubqueries(root *ASTNode, res []*Subquery) []*Subquery {
+	if s, ok := root.Value.(*Subquery); ok {
+		return append(res, s)
+	}
+	for _, child := range root.Children {
+		res = collectSubqueries(child, res)
+	}
+	return res
+}
+
+// isSubquery returns true if 'in' filter is followed by subquery, e.g. `in(select trace_id where ...)`.
+func isSubquery(lex *lexer) bool {
+	if !lex.IsKeyword("(") {
+		return false
+	}
+	next := *lex
+	next.Next()
+	return next.IsKeyword("select")
+}
+
+// parseSubquery parses subquery in 'in' filter, start is the tail of the query at the field name.
+// Example queries:
+//
+//	trace_id:in(select trace_id where level:error)
+//	user_id:in(select user_id where service:payment-api and status:500 | limit 100)
+func parseSubquery(lex *lexer, fieldName string, mapping *seq.Mapping, start int) (*ASTNode, error) {
+	if !lex.IsKeyword("(") {
+		return nil, fmt.Errorf("expected '(', got %q", lex.Token)
+	}
+	lex.Next()
+	if !lex.IsKeyword("select") {
+		return nil, fmt.Errorf("missing 'select' keyword")
+	}
+	lex.Next()
+
+	selectField, err := parsePipeField(lex)
+	if err != nil {
+		return nil, err
+	}
+	if !lex.IsKeyword("where") {
+		return nil, fmt.Errorf("missing 'where' after selected field, got: %q", lex.Token)
+	}
+	// Lexer tail is right after 'where' keyword.
+	query := lex.q
+	lex.Next()
+
+	root, err := parseSeqQLFilter(lex, mapping, 1)
+	if err != nil {
+		return nil, err
+	}
+	query = strings.TrimSpace(query[:len(query)-lex.tail])
+
+	limit := defaultSubqueryLimit
+	if lex.IsKeyword("|") {
+		lex.Next()
+		if !lex.IsKeywords("limit", "head") {
+			return nil, fmt.Errorf("only 'limit' pipe is supported, got: %q", lex.Token)
+		}
+		lex.Next()
+		limit, err = strconv.Atoi(lex.Token)
+		if err != nil || limit <= 0 || lex.TokenQuoted {
+			return nil, fmt.Errorf("expected positive number of values, got: %q", lex.Token)
+		}
+		if limit > maxSubqueryLimit {
+			return nil, fmt.Errorf("limit %d exceeds maximum of %d values", limit, maxSubqueryLimit)
+		}
+		lex.Next()
+	}
+
+	if !lex.IsKeyword(")") {
+		return nil, fmt.Errorf("expected ')', got %q", lex.Token)
+	}
+	end := len(lex.q)
+	lex.Next()
+
+	return newTokenNode(&Subquery{
+		Field:  fieldName,
+		Select: selectField,
+		Query:  query,
+		Root:   root,
+		Limit:  limit,
+		start:  start,
+		end:    end,
+	}), nil
+}
@@ -0,0 +1,106 @@
+package parser
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-db/seq"
+)
+
+// Substring is an infix wildcard filter like `message:*timeout*` over ngram field.
+// It is evaluated as a conjunction of Grams in the n-gram field (see seq.NgramField).
+// If the value is longer than n-grams, the found grams can be located in different places of the field value,
+// so candidate documents must be verified.
+type Substring struct {
+	Field string
+	// Source is the document field which values are verified.
+	// It differs from Field for ngram subfields, e.g. it is "message" for "message.ngram".
+	Source string
+	Value  string
+	Grams  []string
+	// Verify is true if documents found by grams must be checked to contain the value.
+	Verify        bool
+	CaseSensitive bool
+}
+
+func (n *Substring) literal() *Literal {
+	return &Literal{
+		Field: n.Field,
+		Terms: []Term{
+			{Kind: TermSymbol, Data: "*"},
+			{Kind: TermText, Data: n.Value},
+			{Kind: TermSymbol, Data: "*"},
+		},
+	}
+}
+
+// IgnoreCaseRegexp returns expression which matches values of the field containing the substring ignoring case.
+func (n *Substring) IgnoreCaseRegexp() *Regexp {
+	return n.literal().IgnoreCaseRegexp()
+}
+
+func (n *Substring) Dump(builder *strings.Builder) {
+	n.literal().Dump(builder)
+}
+
+func (n *Substring) DumpSeqQL(b *strings.Builder) {
+	n.literal().DumpSeqQL(b)
+}
+
+// rewriteNgramFilters replaces infix wildcard literals of ngram fields with Substring filters.
+func rewriteNgramFilters(root *ASTNode, mapping *seq.Mapping) {
+	for _, child := range root.Children {
+		rewriteNgramFilters(child, mapping)
+	}
+	literal, ok := root.Value.(*Literal)
+	if !ok || indexType(mapping, literal.Field) != seq.TokenizerTypeNgram {
+		return
+	}
+	if substring := newSubstring(literal, mapping); substring != nil {
+		root.Value = substring
+	}
+}
+
+// newSubstring returns Substring filter for literals like `*value*`.
+// It returns nil for other literals and for values shorter than the minimal n-gram, which are searched as is.
+func newSubstring(literal *Literal, mapping *seq.Mapping) *Substring {
+	terms := literal.Terms
+	if len(terms) != 3 || !terms[0].IsWildcard() || terms[1].Kind != TermText || !terms[2].IsWildcard() {
+		return nil
+	}
+
+	types, _ := mapping.Lookup(literal.Field)
+	minGram, maxGram := types.Main.GramLengths()
+	value := terms[1].Data
+	length := utf8.RuneCountInString(value)
+	if length < minGram {
+		return nil
+	}
+
+	s := &Substring{
+		Field:         literal.Field,
+		Source:        sourceField(mapping, literal.Field),
+		Value:         value,
+		CaseSensitive: literal.CaseSensitive,
+	}
+	if length <= maxGram {
+		s.Grams = []string{value}
+		return s
+	}
+
+	// Value is covered by overlapping grams of maximal length.
+	s.Verify = true
+	starts := make([]int, 0, length)
+	for i := range value {
+		starts = append(starts, i)
+	}
+	starts = append(starts, len(value))
+	for i := 0; i+maxGram < len(starts); i++ {
+		gram := value[starts[i]:starts[i+maxGram]]
+		if !slices.Contains(s.Grams, gram) {
+			s.Grams = append(s.Grams, gram)
+		}
+	}
+	return s
+}
@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"math"
 	"net/netip"
+	"regexp"
 	"strconv"
+	"time"
 
+	"github.com/ozontech/seq-db/consts"
 	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
 	"github.com/ozontech/seq-db/util"
 )
 
@@ -268,6 +272,210 @@ func (s *rangeNumberSearch) check(rawVal []byte) bool {
 	return true
 }
 
+// rangeBinarySearch searches binary encoded tokens of long, double, date and ip fields.
+// Encoding preserves order, so range borders are compared bytewise and
+// search is narrowed with binary search for ordered token providers.
+type rangeBinarySearch struct {
+	baseSearch
+	from     []byte
+	to       []byte
+	narrowed bool
+}
+
+func newRangeBinarySearch(base baseSearch, token parser.Token) *rangeBinarySearch {
+	from, to, ok := BinaryRangeBorders(token)
+	if !ok {
+		return nil
+	}
+	return &rangeBinarySearch{
+		baseSearch: base,
+		from:       from,
+		to:         to,
+	}
+}
+
+// IsBinaryRange returns true if token is a range over binary encoded tokens of numeric or ip field.
+func IsBinaryRange(token parser.Token) bool {
+	switch t := token.(type) {
+	case *parser.Range:
+		return seq.IsNumericType(t.IndexType)
+	case *parser.IPRange:
+		return t.IndexType == seq.TokenizerTypeIP
+	}
+	return false
+}
+
+// BinaryRangeBorders returns inclusive binary encoded borders of numeric or ip range.
+// It returns false if the range can't match anything.
+func BinaryRangeBorders(token parser.Token) ([]byte, []byte, bool) {
+	switch t := token.(type) {
+	case *parser.Range:
+		return NumericRangeBorders(t)
+	case *parser.IPRange:
+		return IPRangeBorders(t)
+	}
+	return nil, nil, false
+}
+
+// IPRangeBorders returns inclusive binary encoded borders of ip range.
+func IPRangeBorders(token *parser.IPRange) ([]byte, []byte, bool) {
+	from, ok := seq.AppendParsedIP(nil, token.From.Data)
+	if !ok {
+		return nil, nil, false
+	}
+	to, ok := seq.AppendParsedIP(nil, token.To.Data)
+	if !ok || bytes.Compare(from, to) > 0 {
+		return nil, nil, false
+	}
+	return from, to, true
+}
+
+// NumericRangeBorders returns inclusive binary encoded borders of numeric range.
+// It returns false if the range can't match anything.
+func NumericRangeBorders(token *parser.Range) ([]byte, []byte, bool) {
+	from, ok := numericBorder(token.IndexType, token.From, token.IncludeFrom, 1)
+	if !ok {
+		return nil, nil, false
+	}
+	to, ok := numericBorder(token.IndexType, token.To, token.IncludeTo, -1)
+	if !ok || bytes.Compare(from, to) > 0 {
+		return nil, nil, false
+	}
+	return from, to, true
+}
+
+// numericBorder returns inclusive encoded border of numeric range.
+// Exclusive border is moved to the next representable value in the given direction.
+// Open border is encoded as the minimum or maximum value depending on the direction.
+func numericBorder(t seq.TokenizerType, term parser.Term, include bool, dir int) ([]byte, bool) {
+	if term.IsWildcard() {
+		if dir > 0 {
+			return make([]byte, seq.NumericTokenSize), true
+		}
+		return bytes.Repeat([]byte{0xff}, seq.NumericTokenSize), true
+	}
+	switch t {
+	case seq.TokenizerTypeLong:
+		v, ok := seq.ParseLong(term.Data)
+		if !ok {
+			return nil, false
+		}
+		return longBorder(v, include, dir)
+	case seq.TokenizerTypeDouble:
+		v, ok := seq.ParseDouble(term.Data)
+		if !ok {
+			return nil, false
+		}
+		if !include {
+			v = math.Nextafter(v, math.Inf(dir))
+			if isNaNOrInf(v) {
+				return nil, false // empty range
+			}
+		}
+		return seq.AppendDouble(nil, v), true
+	case seq.TokenizerTypeDate:
+		// Relative dates are resolved by parser.
+		date, ok := seq.ParseDate(term.Data)
+		if !ok {
+			return nil, false
+		}
+		return dateBorder(date, include, dir)
+	}
+	return nil, false
+}
+
+// dateBorder returns inclusive encoded border of date range.
+// Dates are stored with millisecond precision, so border with sub-millisecond part
+// is moved to the nearest stored value inside the range.
+func dateBorder(date time.Time, include bool, dir int) ([]byte, bool) {
+	ms := seq.DateMillis(date)
+	if date.Equal(time.UnixMilli(ms)) {
+		return longBorder(ms, include, dir)
+	}
+	if dir > 0 {
+		ms++
+	}
+	return seq.AppendLong(nil, ms), true
+}
+
+func longBorder(v int64, include bool, dir int) ([]byte, bool) {
+	if !include {
+		if (dir > 0 && v == math.MaxInt64) || (dir < 0 && v == math.MinInt64) {
+			return nil, false // empty range
+		}
+		v += int64(dir)
+	}
+	return seq.AppendLong(nil, v), true
+}
+
+func (s *rangeBinarySearch) Narrow(tp tokenProvider) {
+	s.narrowed = true
+	s.first = util.BinSearchInRange(s.first, s.last, func(tid int) bool {
+		return bytes.Compare(tp.GetToken(uint32(tid)), s.from) >= 0
+	})
+	s.last = util.BinSearchInRange(s.first, s.last, func(tid int) bool {
+		return bytes.Compare(tp.GetToken(uint32(tid)), s.to) > 0
+	}) - 1
+}
+
+func (s *rangeBinarySearch) check(val []byte) bool {
+	if len(val) != len(s.from) {
+		return false
+	}
+	if s.narrowed {
+		return true
+	}
+	return bytes.Compare(s.from, val) <= 0 && bytes.Compare(val, s.to) <= 0
+}
+
+// regexpSearch checks tokens with regular expression.
+// Tokens are narrowed with the literal prefix of the expression for ordered token providers.
+type regexpSearch struct {
+	baseSearch
+	re       *regexp.Regexp
+	prefix   []byte
+	narrowed bool
+	// checked is the number of tokens matched with the expression.
+	checked int
+}
+
+func newRegexpSearch(base baseSearch, token *parser.Regexp) *regexpSearch {
+	re, err := token.Compile()
+	if err != nil {
+		return nil
+	}
+	return &regexpSearch{
+		baseSearch: base,
+		re:         re,
+		prefix:     []byte(token.Prefix()),
+	}
+}
+
+func (s *regexpSearch) Narrow(tp tokenProvider) {
+	s.narrowed = true
+	l := len(s.prefix)
+	s.first = util.BinSearchInRange(s.first, s.last, func(tid int) bool {
+		return bytes.Compare(cut(tp.GetToken(uint32(tid)), l), s.prefix) >= 0
+	})
+	s.last = util.BinSearchInRange(s.first, s.last, func(tid int) bool {
+		return bytes.Compare(cut(tp.GetToken(uint32(tid)), l), s.prefix) > 0
+	}) - 1
+}
+
+func (s *regexpSearch) check(val []byte) bool {
+	if !s.narrowed && !bytes.HasPrefix(val, s.prefix) {
+		return false
+	}
+	s.checked++
+	return s.re.Match(val)
+}
+
+type emptySearch struct{}
+
+func (emptySearch) firstTID() uint32    { return 1 }
+func (emptySearch) lastTID() uint32     { return 0 }
+func (emptySearch) check(_ []byte) bool { return false }
+
 type rangeIpSearch struct {
 	baseSearch
 	from netip.Addr
@@ -332,24 +540,59 @@ func newSearcher(token parser.Token, tp tokenProvider) searcher {
 		}
 		return s
 	case *parser.Range:
+		if IsBinaryRange(t) {
+			return newBinarySearcher(base, t, tp)
+		}
 		// try number search
 		if s := newRangeNumberSearch(base, t); s != nil {
 			return s
 		}
 		return newRangeTextSearch(base, t)
 	case *parser.IPRange:
+		if IsBinaryRange(t) {
+			return newBinarySearcher(base, t, tp)
+		}
 		return newRangeIPSearch(base, t)
+	case *parser.Regexp:
+		s := newRegexpSearch(base, t)
+		if s == nil {
+			return emptySearch{}
+		}
+		if tp.Ordered() {
+			s.Narrow(tp)
+		}
+		return s
 	}
 	panic(fmt.Sprintf("unknown token type: %T", token))
 }
 
+func newBinarySearcher(base baseSearch, token parser.Token, tp tokenProvider) searcher {
+	s := newRangeBinarySearch(base, token)
+	if s == nil {
+		return emptySearch{}
+	}
+	if tp.Ordered() {
+		s.Narrow(tp)
+	}
+	return s
+}
+
 func isNaNOrInf(f float64) bool {
 	return math.IsNaN(f) || math.IsInf(f, 0)
 }
 
-func Search(ctx context.Context, t parser.Token, tp tokenProvider) ([]uint32, error) {
+// Limits restricts amount of tokens processed by a single search.
+type Limits struct {
+	// MaxRegexpTokens is the maximum number of tokens matched with regular expression.
+	// Tokens that don't have the literal prefix of the expression are not counted.
+	// Zero means no limit.
+	MaxRegexpTokens int
+}
+
+func Search(ctx context.Context, t parser.Token, tp tokenProvider, limits Limits) ([]uint32, error) {
 	tids := []uint32{}
 	s := newSearcher(t, tp)
+	re, _ := s.(*regexpSearch)
 	for tid := s.firstTID(); tid <= s.lastTID(); tid++ {
 		if util.IsCancelled(ctx) {
 			return nil, ctx.Err()
@@ -357,6 +600,9 @@ func Search(ctx context.Context, t parser.Token, tp tokenProvider) ([]uint32, er
 		if s.check(tp.GetToken(tid)) {
 			tids = append(tids, tid)
 		}
+		if re != nil && limits.MaxRegexpTokens > 0 && re.checked > limits.MaxRegexpTokens {
+			return nil, fmt.Errorf("%w: limit is %d", consts.ErrTooManyRegexpTokens, limits.MaxRegexpTokens)
+		}
 	}
 	return tids, nil
 }
@@ -1,18 +1,23 @@
 package pattern
 
 import (
+	"context"
 	"errors"
 	"math"
 	"math/rand"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ozontech/seq-db/consts"
 	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
 )
 
 type testTokenProvider struct {
@@ -397,6 +402,117 @@ func TestRangeNumberWildcard(t *testing.T) {
 	testAll(t, tp, tests)
 }
 
+func searchNumeric(t *testing.T, indexType seq.TokenizerType, values []string, req string, expect []string) {
+	data := make([]string, 0, len(values))
+	for _, v := range values {
+		token, ok := seq.AppendNumeric(nil, indexType, v)
+		require.True(t, ok, "bad value %q", v)
+		data = append(data, string(token))
+	}
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{"m": seq.NewSingleType(indexType, "", 0)}}
+	query, err := parser.ParseSeqQL("m:"+req, mapping)
+	require.NoError(t, err)
+
+	tp := newTestTokenProvider(data)
+	for _, p := range []*simpleTokenProvider{tp.shuffled, tp.ordered} {
+		s := newSearcher(query.Root.Value, p)
+		res := []string{}
+		for i := s.firstTID(); i <= s.lastTID(); i++ {
+			val := p.GetToken(i)
+			if s.check(val) {
+				res = append(res, seq.FormatToken(indexType, val))
+			}
+		}
+		sort.Strings(res)
+		expect = slices.Clone(expect)
+		sort.Strings(expect)
+		assert.Equal(t, expect, res, "request %q failed, ordered=%v", req, p.Ordered())
+	}
+}
+
+func TestRangeLong(t *testing.T) {
+	maxInt64 := strconv.Itoa(math.MaxInt64)
+	minInt64 := strconv.Itoa(math.MinInt64)
+	values := []string{"-15", "-12", "-3", "0", "1", "12", "15", "44", "45", "46", "120481", maxInt64, minInt64}
+
+	tests := []testCase{
+		{"[2, 16]", []string{"12", "15"}},
+		{"[1, 1]", []string{"1"}},
+		{"(1, 1)", []string{}},
+		{"(44, 46)", []string{"45"}},
+		{"[44, 46)", []string{"44", "45"}},
+		{"(44, 46]", []string{"45", "46"}},
+		{"[-16, -10]", []string{"-12", "-15"}},
+		{"[16, 2]", []string{}},
+		{"[*, -12]", []string{minInt64, "-15", "-12"}},
+		{"(120481, *]", []string{maxInt64}},
+		{"(" + maxInt64 + ", *]", []string{}},
+		{"[*, " + minInt64 + ")", []string{}},
+		{"[*, *]", values},
+		{"12", []string{"12"}},
+		{"12.0", []string{"12"}},
+	}
+
+	for _, test := range tests {
+		searchNumeric(t, seq.TokenizerTypeLong, values, test.query, test.expect)
+	}
+}
+
+func TestRangeDouble(t *testing.T) {
+	values := []string{"-1.5", "-0.5", "0", "0.25", "1", "1.5", "100", "1e300", "-1e300"}
+
+	tests := []testCase{
+		{"[0, 1]", []string{"0", "0.25", "1"}},
+		{"(0, 1)", []string{"0.25"}},
+		{"[-1, 0)", []string{"-0.5"}},
+		{"[-0, 0]", []string{"0"}},
+		{"(1, *]", []string{"1.5", "100", "1e+300"}},
+		{"[*, -1]", []string{"-1.5", "-1e+300"}},
+		{"[2, 99]", []string{}},
+		{"0.25", []string{"0.25"}},
+	}
+
+	for _, test := range tests {
+		searchNumeric(t, seq.TokenizerTypeDouble, values, test.query, test.expect)
+	}
+}
+
+func TestRangeDate(t *testing.T) {
+	// Dates are stored with millisecond precision.
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	dates := []time.Time{
+		time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		now.Add(-2 * time.Hour),
+		now.Add(time.Hour),
+		time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	values := make([]string, 0, len(dates))
+	for _, d := range dates {
+		values = append(values, d.Format(time.RFC3339Nano))
+	}
+
+	tests := []testCase{
+		{"[2024-01-01T00:00:00Z, 2024-12-31T00:00:00Z]", values[2:4]},
+		{"(2024-01-01T00:00:00Z, 2024-12-31T00:00:00Z]", values[3:4]},
+		{"[*, 2024-01-01T03:00:00+03:00]", values[0:3]},
+		{"[2024-01-01T00:00:00Z, now-1h]", values[2:5]},
+		{"[now-1h, *]", values[5:]},
+		{"(now, 3000-01-01T00:00:00Z)", values[5:6]},
+		{"[*, 1700-01-01T00:00:00Z]", values[0:1]},
+		{"(2024-06-01T12:00:00.0000001Z, 2024-06-01T12:00:00.0009Z]", []string{}},
+		{"[2024-06-01T11:59:59.9999Z, 2024-06-01T12:00:00.0009Z]", values[3:4]},
+		{`"2024-06-01 12:00:00"`, values[3:4]},
+		{`"9999-12-31 00:00:00"`, values[6:]},
+	}
+
+	for _, test := range tests {
+		searchNumeric(t, seq.TokenizerTypeDate, values, test.query, test.expect)
+	}
+}
+
 func TestRangeText(t *testing.T) {
 	tp := newTestTokenProvider([]string{
 		"ab",
@@ -484,6 +600,90 @@ func TestPatternIPRange(t *testing.T) {
 	testAll(t, tp, tests)
 }
 
+func TestPatternIPBinary(t *testing.T) {
+	values := []string{"10.0.0.1", "10.0.0.255", "10.1.2.3", "11.0.0.1", "192.168.1.1", "::1", "2001:db8::1", "2001:db8:ffff::1", "2001:db9::1"}
+	data := make([]string, 0, len(values))
+	for _, v := range values {
+		token, ok := seq.AppendParsedIP(nil, v)
+		require.True(t, ok, "bad value %q", v)
+		data = append(data, string(token))
+	}
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{"m": seq.NewSingleType(seq.TokenizerTypeIP, "", 0)}}
+	tp := newTestTokenProvider(data)
+
+	tests := []testCase{
+		{`cidr(10.0.0.0/8)`, []string{"10.0.0.1", "10.0.0.255", "10.1.2.3"}},
+		{`cidr(10.0.0.0/24)`, []string{"10.0.0.1", "10.0.0.255"}},
+		{`cidr("2001:db8::/32")`, []string{"2001:db8::1", "2001:db8:ffff::1"}},
+		{`ip_range(10.0.0.2, 11.0.0.1)`, []string{"10.0.0.255", "10.1.2.3", "11.0.0.1"}},
+		{`ip_range(0.0.0.0, 255.255.255.255)`, []string{"10.0.0.1", "10.0.0.255", "10.1.2.3", "11.0.0.1", "192.168.1.1"}},
+		{`"2001:0DB8:0:0::1"`, []string{"2001:db8::1"}},
+		{`"::ffff:192.168.1.1"`, []string{"192.168.1.1"}},
+		{`12.0.0.1`, []string{}},
+	}
+
+	for _, test := range tests {
+		query, err := parser.ParseSeqQL("m:"+test.query, mapping)
+		require.NoError(t, err)
+
+		for _, p := range []*simpleTokenProvider{tp.shuffled, tp.ordered} {
+			s := newSearcher(query.Root.Value, p)
+			res := []string{}
+			for i := s.firstTID(); i <= s.lastTID(); i++ {
+				val := p.GetToken(i)
+				if s.check(val) {
+					res = append(res, seq.FormatToken(seq.TokenizerTypeIP, val))
+				}
+			}
+			sort.Strings(res)
+			sort.Strings(test.expect)
+			assert.Equal(t, test.expect, res, "request %q failed, ordered=%v", test.query, p.Ordered())
+		}
+	}
+}
+
+func TestPatternRegexp(t *testing.T) {
+	tp := newTestTokenProvider([]string{
+		"ab1-123",
+		"ab12-456",
+		"ab-123",
+		"abc1-123",
+		"xab1-123",
+		"error",
+		"stderr",
+		"errors",
+	})
+
+	tests := []testCase{
+		{`re("ab[0-9]+-\\d{3}")`, []string{"ab1-123", "ab12-456"}},
+		{"re(`ab[0-9]+-\\d{3}`)", []string{"ab1-123", "ab12-456"}},
+		{`re("err.*")`, []string{"error", "errors"}},
+		{`re("ERR.*")`, []string{"error", "errors"}},
+		{`re(".*err.*")`, []string{"error", "stderr", "errors"}},
+		{`re("error|stderr")`, []string{"error", "stderr"}},
+		{`re("err")`, []string{}},
+		{`re("z.*")`, []string{}},
+	}
+
+	testAll(t, tp, tests)
+}
+
+func TestPatternRegexpLimit(t *testing.T) {
+	tp := newTestTokenProvider([]string{"a1", "a2", "a3", "b1", "b2"})
+
+	token, err := parseSingleTokenForTests(`m:re("a.")`)
+	require.NoError(t, err)
+
+	for _, p := range []*simpleTokenProvider{tp.shuffled, tp.ordered} {
+		tids, err := Search(context.Background(), token, p, Limits{MaxRegexpTokens: 3})
+		require.NoError(t, err)
+		assert.Len(t, tids, 3)
+
+		_, err = Search(context.Background(), token, p, Limits{MaxRegexpTokens: 2})
+		require.ErrorIs(t, err, consts.ErrTooManyRegexpTokens)
+	}
+}
+
 func testFindSequence(a *assert.Assertions, cnt int, needles []string, haystack string) {
 	var needlesB [][]byte
 	for _, needle := range needles {
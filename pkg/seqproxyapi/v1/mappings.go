@@ -8,13 +8,14 @@ import (
 )
 
 var funcMappings = []AggFunc{
-	seq.AggFuncCount:    AggFunc_AGG_FUNC_COUNT,
-	seq.AggFuncSum:      AggFunc_AGG_FUNC_SUM,
-	seq.AggFuncMin:      AggFunc_AGG_FUNC_MIN,
-	seq.AggFuncMax:      AggFunc_AGG_FUNC_MAX,
-	seq.AggFuncAvg:      AggFunc_AGG_FUNC_AVG,
-	seq.AggFuncQuantile: AggFunc_AGG_FUNC_QUANTILE,
-	seq.AggFuncUnique:   AggFunc_AGG_FUNC_UNIQUE,
+	seq.AggFuncCount:       AggFunc_AGG_FUNC_COUNT,
+	seq.AggFuncSum:         AggFunc_AGG_FUNC_SUM,
+	seq.AggFuncMin:         AggFunc_AGG_FUNC_MIN,
+	seq.AggFuncMax:         AggFunc_AGG_FUNC_MAX,
+	seq.AggFuncAvg:         AggFunc_AGG_FUNC_AVG,
+	seq.AggFuncQuantile:    AggFunc_AGG_FUNC_QUANTILE,
+	seq.AggFuncUnique:      AggFunc_AGG_FUNC_UNIQUE,
+	seq.AggFuncCardinality: AggFunc_AGG_FUNC_CARDINALITY,
 }
 
 var funcMappingsPb = func() []seq.AggFunc {
@@ -40,6 +41,21 @@ func (f AggFunc) MustAggFunc() seq.AggFunc {
 	return aggFunc
 }
 
+func ToProtoAggFunc(f seq.AggFunc) (AggFunc, error) {
+	if int(f) >= len(funcMappings) {
+		return 0, fmt.Errorf("unknown function")
+	}
+	return funcMappings[f], nil
+}
+
+func MustProtoAggFunc(f seq.AggFunc) AggFunc {
+	v, err := ToProtoAggFunc(f)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 var orderMappings = []Order{
 	seq.DocsOrderAsc:  Order_ORDER_ASC,
 	seq.DocsOrderDesc: Order_ORDER_DESC,
@@ -7,6 +7,7 @@ import (
 
 	insaneJSON "github.com/ozontech/insane-json"
 
+	"github.com/ozontech/seq-db/analyzer"
 	"github.com/ozontech/seq-db/frac"
 	"github.com/ozontech/seq-db/seq"
 	"github.com/ozontech/seq-db/tokenizer"
@@ -17,11 +18,33 @@ import (
 // keeps a list of extracted tokens
 type indexer struct {
 	tokenizers map[seq.TokenizerType]tokenizer.Tokenizer
-	mapping    seq.Mapping
+	// caseTokenizers are used for fields which override case sensitivity in the mapping.
+	caseTokenizers map[bool]map[seq.TokenizerType]tokenizer.Tokenizer
+	mapping        *seq.Mapping
+	// resolved are types of the fields matched with the templates of the mapping.
+	resolved map[string]resolvedField
 
 	metas []frac.MetaData
 }
 
+type resolvedField struct {
+	types seq.MappingTypes
+	ok    bool
+}
+
+// maxResolvedFields limits the number of cached field names, so arbitrary keys of documents can't grow it unbounded.
+const maxResolvedFields = 16384
+
+func newIndexer(mapping *seq.Mapping, tokenizers map[seq.TokenizerType]tokenizer.Tokenizer, caseTokenizers map[bool]map[seq.TokenizerType]tokenizer.Tokenizer) *indexer {
+	return &indexer{
+		tokenizers:     tokenizers,
+		caseTokenizers: caseTokenizers,
+		mapping:        mapping,
+		resolved:       map[string]resolvedField{},
+		metas:          []frac.MetaData{},
+	}
+}
+
 // Index returns a list of metadata of the given json node.
 // Return at least one metadata. May return more if there are nested fields in the mapping.
 // Each metadata has special token _all_, we use to find stored documents.
@@ -75,12 +98,18 @@ func (i *indexer) decodeInternal(n *insaneJSON.Node, id seq.ID, name []byte, met
 				All:  []seq.MappingType{mappingType},
 			}
 		default:
-			mappingTypes = i.mapping[string(fieldName)]
+			var mapped bool
+			mappingTypes, mapped = i.lookup(fieldName)
+			if !mapped && len(i.mapping.Templates) > 0 && field.AsFieldValue().IsObject() {
+				// Fields of the object can match the templates.
+				i.decodeInternal(field.AsFieldValue(), id, fieldName, metaIndex)
+				continue
+			}
 		}
 
 		mainType = mappingTypes.Main.TokenizerType
 		if mainType == seq.TokenizerTypeNoop {
-			// Field is not in the mapping.
+			// Field is not in the mapping or is not indexed.
 			continue
 		}
 
@@ -109,7 +138,32 @@ func (i *indexer) decodeInternal(n *insaneJSON.Node, id seq.ID, name []byte, met
 	}
 }
 
+// lookup returns types of the field (see seq.Mapping.Lookup).
+// Fields matched with the mapping templates are cached, since matching all the templates is expensive.
+func (i *indexer) lookup(field []byte) (seq.MappingTypes, bool) {
+	if types, ok := i.mapping.Fields[string(field)]; ok {
+		return types, true
+	}
+	if len(i.mapping.Templates) == 0 {
+		return seq.MappingTypes{}, false
+	}
+	if r, ok := i.resolved[string(field)]; ok {
+		return r.types, r.ok
+	}
+	types, ok := i.mapping.Lookup(string(field))
+	if len(i.resolved) >= maxResolvedFields {
+		clear(i.resolved)
+	}
+	i.resolved[string(field)] = resolvedField{types: types, ok: ok}
+	return types, ok
+}
+
 func (i *indexer) index(tokenTypes seq.MappingTypes, tokens []frac.MetaToken, key, value []byte) []frac.MetaToken {
+	// Tokens refer to the value, which is lowercased in place by case insensitive tokenizers.
+	// So if types of the field may differ in case sensitivity, each of them gets its own copy.
+	copyValue := len(tokenTypes.All) > 1 && slices.ContainsFunc(tokenTypes.All, func(t seq.MappingType) bool {
+		return t.CaseSensitive != nil
+	})
 	for _, tokenType := range tokenTypes.All {
 		if _, has := i.tokenizers[tokenType.TokenizerType]; !has {
 			continue
@@ -123,7 +177,11 @@ func (i *indexer) index(tokenTypes seq.MappingTypes, tokens []frac.MetaToken, ke
 		// value can be nil (not the same as empty) in case of tags indexer type,
 		// so don't tokenize it.
 		if value != nil {
-			tokens = i.tokenizers[tokenType.TokenizerType].Tokenize(tokens, title, value, tokenType.MaxSize)
+			v := value
+			if copyValue {
+				v = bytes.Clone(value)
+			}
+			tokens = i.tokenize(tokenType, tokens, title, v)
 		}
 		tokens = append(tokens, frac.MetaToken{
 			Key:   seq.ExistsTokenName,
@@ -133,12 +191,49 @@ func (i *indexer) index(tokenTypes seq.MappingTypes, tokens []frac.MetaToken, ke
 	return tokens
 }
 
+func (i *indexer) tokenize(tokenType seq.MappingType, tokens []frac.MetaToken, title, value []byte) []frac.MetaToken {
+	t := i.tokenizers[tokenType.TokenizerType]
+	if tokenType.CaseSensitive != nil {
+		if ct, ok := i.caseTokenizers[*tokenType.CaseSensitive][tokenType.TokenizerType]; ok {
+			t = ct
+		}
+	}
+	if ngram, ok := t.(*tokenizer.NgramTokenizer); ok {
+		minGram, maxGram := tokenType.GramLengths()
+		return ngram.TokenizeGrams(tokens, title, value, tokenType.MaxSize, minGram, maxGram)
+	}
+	n := len(tokens)
+	tokens = t.Tokenize(tokens, title, value, tokenType.MaxSize)
+	if tokenType.Analyzer != nil {
+		tokens = analyzeTokens(tokenType.Analyzer, tokens, n)
+	}
+	return tokens
+}
+
+// analyzeTokens applies analyzer to the tokens starting from pos and removes rejected ones.
+// Empty token of empty value is kept as is.
+func analyzeTokens(a *analyzer.Analyzer, tokens []frac.MetaToken, pos int) []frac.MetaToken {
+	res := tokens[:pos]
+	for _, token := range tokens[pos:] {
+		if len(token.Value) > 0 {
+			value, ok := a.Analyze(token.Value)
+			if !ok {
+				continue
+			}
+			token.Value = value
+		}
+		res = append(res, token)
+	}
+	return res
+}
+
 func (i *indexer) decodeTags(n *insaneJSON.Node, name []byte, tokensIndex int) {
 	for _, tag := range n.AsArray() {
 		fieldName := tag.Dig("key").AsBytes()
 		fieldName = bytes.Join([][]byte{name, fieldName}, fieldSeparator)
 		nodeValue := encodeInsaneNode(tag.Dig("value"))
-		i.metas[tokensIndex].Tokens = i.index(i.mapping[string(fieldName)], i.metas[tokensIndex].Tokens, fieldName, nodeValue)
+		types, _ := i.lookup(fieldName)
+		i.metas[tokensIndex].Tokens = i.index(types, i.metas[tokensIndex].Tokens, fieldName, nodeValue)
 	}
 }
 
@@ -45,7 +45,7 @@ var (
 )
 
 type MappingProvider interface {
-	GetMapping() seq.Mapping
+	GetMapping() *seq.Mapping
 	GetRawMapping() *seq.RawMapping
 }
 
@@ -58,6 +58,9 @@ type IngestorConfig struct {
 	MaxInflightBulks       int
 	AllowedTimeDrift       time.Duration
 	FutureAllowedTimeDrift time.Duration
+	// DocTime configures extraction of the document timestamp.
+	// Default fields and formats are used if they are not set.
+	DocTime DocTimeConfig
 
 	MappingProvider MappingProvider
 
@@ -81,7 +84,9 @@ type Ingestor struct {
 	client StorageClient
 
 	tokenizers map[seq.TokenizerType]tokenizer.Tokenizer
-	procPool   *sync.Pool
+	// caseTokenizers are tokenizers of string types by their case sensitivity.
+	caseTokenizers map[bool]map[seq.TokenizerType]tokenizer.Tokenizer
+	procPool       *sync.Pool
 
 	inflight *atomic.Int64
 	bulks    *atomic.Int64
@@ -92,23 +97,38 @@ type Ingestor struct {
 }
 
 func NewIngestor(c IngestorConfig, client StorageClient) *Ingestor {
-	tokenizers := map[seq.TokenizerType]tokenizer.Tokenizer{
-		seq.TokenizerTypeText:    tokenizer.NewTextTokenizer(c.MaxTokenSize, c.CaseSensitive, c.PartialFieldIndexing, consts.MaxTextFieldValueLength),
-		seq.TokenizerTypeKeyword: tokenizer.NewKeywordTokenizer(c.MaxTokenSize, c.CaseSensitive, c.PartialFieldIndexing),
-		seq.TokenizerTypePath:    tokenizer.NewPathTokenizer(c.MaxTokenSize, c.CaseSensitive, c.PartialFieldIndexing),
-		seq.TokenizerTypeExists:  tokenizer.NewExistsTokenizer(),
+	tokenizers := newStringTokenizers(c, c.CaseSensitive)
+	tokenizers[seq.TokenizerTypeExists] = tokenizer.NewExistsTokenizer()
+	tokenizers[seq.TokenizerTypeLong] = tokenizer.NewLongTokenizer()
+	tokenizers[seq.TokenizerTypeDouble] = tokenizer.NewDoubleTokenizer()
+	tokenizers[seq.TokenizerTypeIP] = tokenizer.NewIPTokenizer()
+	tokenizers[seq.TokenizerTypeDate] = tokenizer.NewDateTokenizer()
+
+	// Fields can override case sensitivity in the mapping.
+	caseTokenizers := map[bool]map[seq.TokenizerType]tokenizer.Tokenizer{
+		true:  newStringTokenizers(c, true),
+		false: newStringTokenizers(c, false),
+	}
+
+	defaultDocTime := DefaultDocTimeConfig()
+	if len(c.DocTime.Fields) == 0 {
+		c.DocTime.Fields = defaultDocTime.Fields
+	}
+	if len(c.DocTime.Formats) == 0 {
+		c.DocTime.Formats = defaultDocTime.Formats
 	}
 
 	i := &Ingestor{
-		config:     c,
-		client:     client,
-		tokenizers: tokenizers,
-		inflight:   &atomic.Int64{},
-		bulks:      &atomic.Int64{},
-		docs:       &atomic.Int64{},
-		took:       &atomic.Int64{},
-		stopped:    &atomic.Bool{},
-		procPool:   &sync.Pool{},
+		config:         c,
+		client:         client,
+		tokenizers:     tokenizers,
+		caseTokenizers: caseTokenizers,
+		inflight:       &atomic.Int64{},
+		bulks:          &atomic.Int64{},
+		docs:           &atomic.Int64{},
+		took:           &atomic.Int64{},
+		stopped:        &atomic.Bool{},
+		procPool:       &sync.Pool{},
 	}
 
 	go i.stats()
@@ -116,6 +136,16 @@ func NewIngestor(c IngestorConfig, client StorageClient) *Ingestor {
 	return i
 }
 
+// newStringTokenizers returns tokenizers of string types (see seq.IsStringType) with the given case sensitivity.
+func newStringTokenizers(c IngestorConfig, caseSensitive bool) map[seq.TokenizerType]tokenizer.Tokenizer {
+	return map[seq.TokenizerType]tokenizer.Tokenizer{
+		seq.TokenizerTypeText:    tokenizer.NewTextTokenizer(c.MaxTokenSize, caseSensitive, c.PartialFieldIndexing, consts.MaxTextFieldValueLength),
+		seq.TokenizerTypeKeyword: tokenizer.NewKeywordTokenizer(c.MaxTokenSize, caseSensitive, c.PartialFieldIndexing),
+		seq.TokenizerTypePath:    tokenizer.NewPathTokenizer(c.MaxTokenSize, caseSensitive, c.PartialFieldIndexing),
+		seq.TokenizerTypeNgram:   tokenizer.NewNgramTokenizer(c.MaxTokenSize, caseSensitive, c.PartialFieldIndexing),
+	}
+}
+
 func (i *Ingestor) stats() {
 	for {
 		if i.stopped.Load() {
@@ -261,6 +291,10 @@ func (i *Ingestor) processDocsToCompressor(
 				notAnObjectTotal.Inc()
 				continue
 			}
+			if errors.Is(err, errTimeNotParsable) {
+				logger.Error("unable to process the document because its timestamp is absent or not parsable", zap.Any("document", json.RawMessage(originalDoc)))
+				continue
+			}
 			return total, fmt.Errorf("processing doc: %s", err)
 		}
 		parseDuration += time.Since(parseStart)
@@ -298,7 +332,7 @@ func (i *Ingestor) getProcessor() *processor {
 		return procEface.(*processor)
 	}
 	index := rand.Uint64() % consts.IngestorMaxInstances
-	return newBulkProcessor(i.config.MappingProvider.GetMapping(), i.tokenizers, i.config.AllowedTimeDrift, i.config.FutureAllowedTimeDrift, index)
+	return newBulkProcessor(i.config.MappingProvider.GetMapping(), i.tokenizers, i.caseTokenizers, i.config.AllowedTimeDrift, i.config.FutureAllowedTimeDrift, i.config.DocTime, index)
 }
 
 func (i *Ingestor) putProcessor(proc *processor) {
@@ -25,7 +25,7 @@ func TestProcessDocuments(t *testing.T) {
 
 	ctx := context.Background()
 
-	mappingProvider, err := mappingprovider.New("", mappingprovider.WithMapping(map[string]seq.MappingTypes{
+	mappingProvider, err := mappingprovider.New("", mappingprovider.WithMapping(&seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"path":                                  newMapping(seq.TokenizerTypePath),
 		"level":                                 newMapping(seq.TokenizerTypeKeyword),
 		"message":                               newMapping(seq.TokenizerTypeText),
@@ -69,7 +69,7 @@ func TestProcessDocuments(t *testing.T) {
 		"spans2.span_id":                        newMapping(seq.TokenizerTypeKeyword),
 		"spans2.operation_name":                 newMapping(seq.TokenizerTypeKeyword),
 		"exists_only":                           newMapping(seq.TokenizerTypeExists),
-	}))
+	}}))
 	require.NoError(t, err)
 
 	cfg := IngestorConfig{
@@ -469,12 +469,12 @@ func (f *FakeClient) StoreDocuments(_ context.Context, total int, docs, metas []
 func BenchmarkProcessDocuments(b *testing.B) {
 	ctx := context.Background()
 
-	mappingProvider, err := mappingprovider.New("", mappingprovider.WithMapping(map[string]seq.MappingTypes{
+	mappingProvider, err := mappingprovider.New("", mappingprovider.WithMapping(&seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"level":   seq.NewSingleType(seq.TokenizerTypeKeyword, "level", int(units.KiB)),
 		"message": seq.NewSingleType(seq.TokenizerTypeText, "message", int(units.KiB)),
 		"error":   seq.NewSingleType(seq.TokenizerTypeText, "error", int(units.KiB)),
 		"shard":   seq.NewSingleType(seq.TokenizerTypeKeyword, "shard", int(units.KiB)),
-	}))
+	}}))
 	if err != nil {
 		b.Fatal(err)
 	}
@@ -561,7 +561,7 @@ func TestProcessDocumentType(t *testing.T) {
 	test := func(doc string, expected int) {
 		t.Helper()
 		client := &FakeClient{}
-		mp, err := mappingprovider.New("", mappingprovider.WithMapping(map[string]seq.MappingTypes{}))
+		mp, err := mappingprovider.New("", mappingprovider.WithMapping(&seq.Mapping{Fields: map[string]seq.MappingTypes{}}))
 		r.NoError(err)
 		ingestor := NewIngestor(IngestorConfig{MaxInflightBulks: 1, MappingProvider: mp}, client)
 		defer ingestor.Stop()
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"math"
 	"math/rand/v2"
+	"strconv"
+	"strings"
 	"time"
 
 	insaneJSON "github.com/ozontech/insane-json"
@@ -28,14 +30,34 @@ var (
 	parseErrors  = bulkTimeErrors.WithLabelValues("parse_error")
 	delays       = bulkTimeErrors.WithLabelValues("delay")
 	futureDelays = bulkTimeErrors.WithLabelValues("future_delay")
+	rejected     = bulkTimeErrors.WithLabelValues("rejected")
 )
 
+// DocTimeConfig describes where the document timestamp is located and how it is parsed.
+type DocTimeConfig struct {
+	// Fields are paths to the timestamp field, the first parsable one is used.
+	Fields [][]string
+	// Formats are time.Parse layouts or epoch formats (see consts.TimeFormatUnix).
+	Formats []string
+	// RejectUnparsed drops documents without parsable timestamp instead of using the request time.
+	RejectUnparsed bool
+}
+
+// DefaultDocTimeConfig returns config with fields and formats that are used if nothing is configured.
+func DefaultDocTimeConfig() DocTimeConfig {
+	return DocTimeConfig{
+		Fields:  consts.TimeFields,
+		Formats: consts.TimeFormats,
+	}
+}
+
 // processor accumulates meta and docs from a single bulk
 // returns bulk request ready to be sent to store
 type processor struct {
 	proxyIndex  uint64
 	drift       time.Duration
 	futureDrift time.Duration
+	docTime     DocTimeConfig
 
 	indexer *indexer
 	decoder *insaneJSON.Root
@@ -46,21 +68,23 @@ func init() {
 	insaneJSON.MapUseThreshold = math.MaxInt32
 }
 
-func newBulkProcessor(mapping seq.Mapping, tokenizers map[seq.TokenizerType]tokenizer.Tokenizer, drift, futureDrift time.Duration, index uint64) *processor {
+func newBulkProcessor(
+	mapping *seq.Mapping, tokenizers map[seq.TokenizerType]tokenizer.Tokenizer, caseTokenizers map[bool]map[seq.TokenizerType]tokenizer.Tokenizer,
+	drift, futureDrift time.Duration, docTime DocTimeConfig, index uint64) *processor {
 	return &processor{
 		proxyIndex:  index,
 		drift:       drift,
 		futureDrift: futureDrift,
-		indexer: &indexer{
-			tokenizers: tokenizers,
-			mapping:    mapping,
-			metas:      []frac.MetaData{},
-		},
-		decoder: insaneJSON.Spawn(),
+		docTime:     docTime,
+		indexer:     newIndexer(mapping, tokenizers, caseTokenizers),
+		decoder:     insaneJSON.Spawn(),
 	}
 }
 
-var errNotAnObject = errors.New("not an object")
+var (
+	errNotAnObject     = errors.New("not an object")
+	errTimeNotParsable = errors.New("timestamp is absent or not parsable")
+)
 
 func (p *processor) Process(doc []byte, requestTime time.Time) ([]byte, []frac.MetaData, error) {
 	err := p.decoder.DecodeBytes(doc)
@@ -70,11 +94,15 @@ func (p *processor) Process(doc []byte, requestTime time.Time) ([]byte, []frac.M
 	if !p.decoder.IsObject() {
 		return nil, nil, errNotAnObject
 	}
-	docTime, timeField := extractDocTime(p.decoder.Node, requestTime)
+	docTime, timeField := extractDocTime(p.decoder.Node, p.docTime, requestTime)
 	docDelay := requestTime.Sub(docTime)
 	if timeField == nil {
 		// couldn't parse given event time
 		parseErrors.Inc()
+		if p.docTime.RejectUnparsed {
+			rejected.Inc()
+			return nil, nil, errTimeNotParsable
+		}
 	} else if documentDelayed(docDelay, p.drift, p.futureDrift) {
 		docTime = requestTime
 	}
@@ -99,20 +127,29 @@ func documentDelayed(docDelay, drift, futureDrift time.Duration) bool {
 	return delayed
 }
 
-func extractDocTime(node *insaneJSON.Node, requestTime time.Time) (time.Time, []string) {
-	for _, field := range consts.TimeFields {
+func extractDocTime(node *insaneJSON.Node, cfg DocTimeConfig, requestTime time.Time) (time.Time, []string) {
+	for _, field := range cfg.Fields {
 		timeVal := node.Dig(field...).AsBytes()
 		if len(timeVal) == 0 {
 			continue
 		}
 
-		for _, f := range consts.TimeFormats {
+		for _, f := range cfg.Formats {
 			var t time.Time
 			var ok bool
-			if f == consts.ESTimeFormat {
+			switch f {
+			case consts.ESTimeFormat:
 				// Fallback to optimized es time parsing.
 				t, ok = parseESTime(util.ByteToStringUnsafe(timeVal))
-			} else {
+			case consts.TimeFormatUnix:
+				t, ok = parseUnixTime(util.ByteToStringUnsafe(timeVal), time.Second)
+			case consts.TimeFormatUnixMilli:
+				t, ok = parseUnixTime(util.ByteToStringUnsafe(timeVal), time.Millisecond)
+			case consts.TimeFormatUnixMicro:
+				t, ok = parseUnixTime(util.ByteToStringUnsafe(timeVal), time.Microsecond)
+			case consts.TimeFormatUnixNano:
+				t, ok = parseUnixTime(util.ByteToStringUnsafe(timeVal), time.Nanosecond)
+			default:
 				var err error
 				t, err = time.Parse(f, util.ByteToStringUnsafe(timeVal))
 				ok = err == nil
@@ -126,6 +163,50 @@ func extractDocTime(node *insaneJSON.Node, requestTime time.Time) (time.Time, []
 	return defaultTime, nil
 }
 
+// parseUnixTime parses epoch time in the given units, e.g. "1713549865.999" for seconds.
+// Fractional part is allowed for units greater than nanosecond.
+func parseUnixTime(s string, unit time.Duration) (time.Time, bool) {
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if intPart == "" || intPart[0] == '+' {
+		return time.Time{}, false
+	}
+	v, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || v > math.MaxInt64/int64(unit) || v < math.MinInt64/int64(unit) {
+		return time.Time{}, false
+	}
+	nsecs := v * int64(unit)
+
+	if hasFrac {
+		// Count of fractional digits that are meaningful for the unit, e.g. 9 for seconds.
+		digits := len(strconv.FormatInt(int64(unit), 10)) - 1
+		if fracPart == "" {
+			return time.Time{}, false
+		}
+		for _, c := range []byte(fracPart) {
+			if c < '0' || c > '9' {
+				return time.Time{}, false
+			}
+		}
+		if len(fracPart) > digits {
+			fracPart = fracPart[:digits]
+		}
+		frac := int64(0)
+		if fracPart != "" {
+			frac, _ = strconv.ParseInt(fracPart, 10, 64)
+			frac *= int64(math.Pow10(digits - len(fracPart)))
+		}
+		if intPart[0] == '-' {
+			frac = -frac
+		}
+		if frac > 0 && nsecs > math.MaxInt64-frac || frac < 0 && nsecs < math.MinInt64-frac {
+			return time.Time{}, false
+		}
+		nsecs += frac
+	}
+
+	return time.Unix(0, nsecs).UTC(), true
+}
+
 // parseESTime parses time in "2006-01-02 15:04:05.999" format.
 // It is copied and modified stdlib function time.parseRFC3339.
 func parseESTime(t string) (time.Time, bool) {
@@ -1,6 +1,7 @@
 package bulk
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/tokenizer"
 )
 
 func TestExtractDocTime(t *testing.T) {
@@ -58,7 +61,7 @@ func TestExtractDocTime(t *testing.T) {
 
 			require.NoError(t, root.DecodeBytes(tc.input))
 
-			docTime, timeField := extractDocTime(root.Node, time.Now())
+			docTime, timeField := extractDocTime(root.Node, DefaultDocTimeConfig(), time.Now())
 			assert.Equal(t, tc.expectedTime, docTime)
 			assert.Equal(t, tc.expectedField, timeField)
 		})
@@ -78,12 +81,236 @@ func TestExtractDocTimeUnknownTimeFormat(t *testing.T) {
 	for _, input := range inputs {
 		assert.NoError(t, root.DecodeBytes(input))
 
-		docTime, timeField := extractDocTime(root.Node, time.Now())
+		docTime, timeField := extractDocTime(root.Node, DefaultDocTimeConfig(), time.Now())
 		assert.Nil(t, timeField)
 		assert.NotEqual(t, 1, docTime.Year())
 	}
 }
 
+func TestExtractDocTimeCustomConfig(t *testing.T) {
+	cfg := DocTimeConfig{
+		Fields: [][]string{{"event", "created"}, {"ts"}},
+		Formats: []string{
+			time.RFC3339,
+			consts.TimeFormatUnixNano,
+		},
+	}
+
+	root := insaneJSON.Spawn()
+	defer insaneJSON.Release(root)
+
+	require.NoError(t, root.DecodeBytes([]byte(`{"event": {"created": "2024-04-19T18:04:25Z"}, "ts": 1}`)))
+	docTime, timeField := extractDocTime(root.Node, cfg, time.Now())
+	assert.Equal(t, time.Date(2024, 4, 19, 18, 4, 25, 0, time.UTC), docTime)
+	assert.Equal(t, []string{"event", "created"}, timeField)
+
+	require.NoError(t, root.DecodeBytes([]byte(`{"event": {"created": "yesterday"}, "ts": 1713549865999999999}`)))
+	docTime, timeField = extractDocTime(root.Node, cfg, time.Now())
+	assert.Equal(t, time.Date(2024, 4, 19, 18, 4, 25, 999999999, time.UTC), docTime)
+	assert.Equal(t, []string{"ts"}, timeField)
+
+	requestTime := time.Now()
+	require.NoError(t, root.DecodeBytes([]byte(`{"timestamp": "2024-04-19T18:04:25Z"}`)))
+	docTime, timeField = extractDocTime(root.Node, cfg, requestTime)
+	assert.Equal(t, requestTime, docTime)
+	assert.Nil(t, timeField)
+}
+
+func TestParseUnixTime(t *testing.T) {
+	type testCase struct {
+		input    string
+		unit     time.Duration
+		expected time.Time
+		ok       bool
+	}
+
+	expected := time.Date(2024, 4, 19, 18, 4, 25, 0, time.UTC)
+	testCases := []testCase{
+		{input: "1713549865", unit: time.Second, expected: expected, ok: true},
+		{input: "1713549865.5", unit: time.Second, expected: expected.Add(500 * time.Millisecond), ok: true},
+		{input: "1713549865.123456789999", unit: time.Second, expected: expected.Add(123456789), ok: true},
+		{input: "1713549865123", unit: time.Millisecond, expected: expected.Add(123 * time.Millisecond), ok: true},
+		{input: "1713549865123.5", unit: time.Millisecond, expected: expected.Add(123500 * time.Microsecond), ok: true},
+		{input: "1713549865123456", unit: time.Microsecond, expected: expected.Add(123456 * time.Microsecond), ok: true},
+		{input: "1713549865123456789", unit: time.Nanosecond, expected: expected.Add(123456789), ok: true},
+		{input: "-1.5", unit: time.Second, expected: time.Unix(-1, -500000000).UTC(), ok: true},
+		{input: "1713549865123456789.5", unit: time.Nanosecond, expected: expected.Add(123456789), ok: true},
+		{input: "", unit: time.Second},
+		{input: "+1", unit: time.Second},
+		{input: "1.", unit: time.Second},
+		{input: "1.-5", unit: time.Second},
+		{input: "2024-04-19", unit: time.Second},
+		{input: "1713549865123456789", unit: time.Second},
+		// Bounds of the time in nanoseconds.
+		{input: "9223372036.854775807", unit: time.Second, expected: time.Unix(0, math.MaxInt64).UTC(), ok: true},
+		{input: "9223372036.854775808", unit: time.Second},
+		{input: "9223372036.9", unit: time.Second},
+		{input: "-9223372036.854775808", unit: time.Second, expected: time.Unix(0, math.MinInt64).UTC(), ok: true},
+		{input: "-9223372036.854775809", unit: time.Second},
+		{input: "9223372036854775.9", unit: time.Microsecond},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, ok := parseUnixTime(tc.input, tc.unit)
+			require.Equal(t, tc.ok, ok)
+			if ok {
+				assert.Equal(t, tc.expected, parsed)
+			}
+		})
+	}
+}
+
+func TestProcessRejectUnparsedTime(t *testing.T) {
+	docTime := DefaultDocTimeConfig()
+	docTime.RejectUnparsed = true
+	proc := newBulkProcessor(&seq.Mapping{}, nil, nil, time.Hour, time.Hour, docTime, 0)
+
+	_, _, err := proc.Process([]byte(`{"message": "hello world"}`), time.Now())
+	assert.ErrorIs(t, err, errTimeNotParsable)
+
+	_, _, err = proc.Process([]byte(`{"message": "hello world", "time": "not a time"}`), time.Now())
+	assert.ErrorIs(t, err, errTimeNotParsable)
+
+	_, _, err = proc.Process([]byte(`{"message": "hello world", "time": "2024-04-19T18:04:25Z"}`), time.Now())
+	assert.NoError(t, err)
+}
+
+func TestProcessAnalyzer(t *testing.T) {
+	mapping, err := seq.ReadMapping([]byte(`
+analyzers:
+  - name: russian
+    stopwords: [_russian_]
+    stemmer: russian
+mapping-list:
+  - name: message
+    type: text
+    analyzer: russian
+  - name: text
+    type: text
+`))
+	require.NoError(t, err)
+	tokenizers := map[seq.TokenizerType]tokenizer.Tokenizer{
+		seq.TokenizerTypeText: tokenizer.NewTextTokenizer(100, false, true, 1024),
+	}
+	proc := newBulkProcessor(mapping, tokenizers, nil, time.Hour, time.Hour, DefaultDocTimeConfig(), 0)
+
+	_, metas, err := proc.Process([]byte(`{"message": "Ошибки при подключении", "text": "Ошибки при подключении", "time": "2024-04-19T18:04:25Z"}`), time.Now())
+	require.NoError(t, err)
+	require.Len(t, metas, 1)
+
+	var message, text []string
+	for _, token := range metas[0].Tokens {
+		switch string(token.Key) {
+		case "message":
+			message = append(message, string(token.Value))
+		case "text":
+			text = append(text, string(token.Value))
+		}
+	}
+	assert.Equal(t, []string{"ошибк", "подключен"}, message)
+	assert.Equal(t, []string{"ошибки", "при", "подключении"}, text)
+}
+
+func TestProcessCaseSensitive(t *testing.T) {
+	mapping, err := seq.ReadMapping([]byte(`
+mapping-list:
+  - name: level
+    type: keyword
+    case_sensitive: true
+  - name: service
+    type: keyword
+  - name: message
+    types:
+      - type: text
+      - title: raw
+        type: keyword
+        case_sensitive: true
+`))
+	require.NoError(t, err)
+	c := IngestorConfig{MaxTokenSize: 100}
+	caseTokenizers := map[bool]map[seq.TokenizerType]tokenizer.Tokenizer{
+		true:  newStringTokenizers(c, true),
+		false: newStringTokenizers(c, false),
+	}
+	proc := newBulkProcessor(mapping, newStringTokenizers(c, false), caseTokenizers, time.Hour, time.Hour, DefaultDocTimeConfig(), 0)
+
+	_, metas, err := proc.Process([]byte(`{"level": "ERROR", "service": "Auth", "message": "Connection Reset", "time": "2024-04-19T18:04:25Z"}`), time.Now())
+	require.NoError(t, err)
+	require.Len(t, metas, 1)
+
+	tokens := map[string][]string{}
+	for _, token := range metas[0].Tokens {
+		if key := string(token.Key); key != seq.TokenAll && key != seq.TokenExists {
+			tokens[key] = append(tokens[key], string(token.Value))
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"level":       {"ERROR"},
+		"service":     {"auth"},
+		"message":     {"connection", "reset"},
+		"message.raw": {"Connection Reset"},
+	}, tokens)
+}
+
+func TestProcessTemplates(t *testing.T) {
+	mapping, err := seq.ReadMapping([]byte(`
+mapping-list:
+  - name: service
+    type: keyword
+mapping-templates:
+  - match: "*.payload"
+    index: false
+  - match: "*_id"
+    type: keyword
+  - match: "*.duration_ms"
+    type: long
+  - match: "msg*"
+    type: text
+`))
+	require.NoError(t, err)
+	c := IngestorConfig{MaxTokenSize: 100}
+	tokenizers := newStringTokenizers(c, false)
+	tokenizers[seq.TokenizerTypeLong] = tokenizer.NewLongTokenizer()
+	proc := newBulkProcessor(mapping, tokenizers, nil, time.Hour, time.Hour, DefaultDocTimeConfig(), 0)
+
+	doc := []byte(`{
+		"service": "api",
+		"user_id": "U1",
+		"msg": "Hello World",
+		"request": {"duration_ms": 15, "payload": {"session_id": "S1"}, "method": "GET"},
+		"level": "info",
+		"time": "2024-04-19T18:04:25Z"
+	}`)
+	for range 2 {
+		// The second document is indexed with the cached fields.
+		_, metas, err := proc.Process(doc, time.Now())
+		require.NoError(t, err)
+		require.Len(t, metas, 1)
+
+		tokens := map[string][]string{}
+		for _, token := range metas[0].Tokens {
+			switch key := string(token.Key); key {
+			case seq.TokenAll:
+			case seq.TokenExists:
+				tokens[key] = append(tokens[key], string(token.Value))
+			case "request.duration_ms":
+				tokens[key] = append(tokens[key], seq.FormatToken(seq.TokenizerTypeLong, token.Value))
+			default:
+				tokens[key] = append(tokens[key], string(token.Value))
+			}
+		}
+		assert.Equal(t, map[string][]string{
+			seq.TokenExists:       {"service", "user_id", "msg", "request.duration_ms"},
+			"service":             {"api"},
+			"user_id":             {"u1"},
+			"msg":                 {"hello", "world"},
+			"request.duration_ms": {"15"},
+		}, tokens)
+	}
+	assert.Len(t, proc.indexer.resolved, 8)
+}
+
 func BenchmarkParseESTime(b *testing.B) {
 	const toParse = "2024-04-19 18:04:25.999"
 	const toParseRFC3339 = "2024-04-19T18:04:25.999Z"
@@ -43,11 +43,19 @@ func (si *Ingestor) StartAsyncSearch(ctx context.Context, r AsyncRequest) (Async
 		return AsyncResponse{}, err
 	}
 
+	from, to := queryTimeRange(r.Query, seq.TimeToMID(r.From), seq.TimeToMID(r.To))
+
+	// Subqueries are resolved at the start of the search like relative dates.
+	query, err := si.resolveSubqueries(ctx, r.Query, from, to, false, nil)
+	if err != nil {
+		return AsyncResponse{}, err
+	}
+
 	req := storeapi.StartAsyncSearchRequest{
 		SearchId:          requestID,
-		Query:             r.Query,
-		From:              r.From.UnixMilli(),
-		To:                r.To.UnixMilli(),
+		Query:             query,
+		From:              int64(from),
+		To:                int64(to),
 		Aggs:              convertToAggsQuery(r.Aggregations),
 		HistogramInterval: int64(r.HistogramInterval),
 		Retention:         durationpb.New(r.Retention),
@@ -211,7 +219,7 @@ func (si *Ingestor) FetchAsyncSearchResult(
 		}
 
 		qpr := responseToQPR(sr.Response, si.sourceByClient[replica], false)
-		seq.MergeQPRs(&pr.QPR, []*seq.QPR{qpr}, r.Size+r.Offset, histInterval, r.Order)
+		seq.MergeQPRs(&pr.QPR, []*seq.QPR{qpr}, r.Size+r.Offset, histInterval, r.Order, nil)
 	}
 
 	var aggQueries []seq.AggregateArgs
@@ -230,8 +238,10 @@ func (si *Ingestor) FetchAsyncSearchResult(
 		if len(aggQueries) == 0 {
 			for _, agg := range storeResp.Aggs {
 				aggQueries = append(aggQueries, seq.AggregateArgs{
-					Func:      agg.Func.MustAggFunc(),
-					Quantiles: agg.Quantiles,
+					Func:          agg.Func.MustAggFunc(),
+					Quantiles:     agg.Quantiles,
+					GroupByFields: 1 + len(agg.ThenBy),
+					Buckets:       bucketsFromProto(agg),
 				})
 			}
 		}
@@ -263,12 +273,22 @@ func (si *Ingestor) FetchAsyncSearchResult(
 	pr.Request = *searchReq
 
 	docsStream := DocsIterator(EmptyDocsStream{})
+	pipeline := newDocsPipeline(pr.Request.Query)
+	if !pipeline.empty() {
+		ids := pr.QPR.IDs[:min(len(pr.QPR.IDs), si.maxScannedDocs())]
+		var err error
+		pr.QPR.IDs, docsStream, err = si.fetchPiped(ctx, ids, r.Offset, r.Size, false, pipeline)
+		if err != nil {
+			return pr, nil, err
+		}
+		return pr, docsStream, nil
+	}
+
 	var size int
 	pr.QPR.IDs, size = paginateIDs(pr.QPR.IDs, r.Offset, r.Size)
 	if size > 0 {
-		fieldsFilter := tryParseFieldsFilter(pr.Request.Query)
 		var err error
-		docsStream, err = si.FetchDocsStream(ctx, pr.QPR.IDs, false, fieldsFilter)
+		docsStream, err = si.FetchDocsStream(ctx, pr.QPR.IDs, false, pipeline.fieldsFilter)
 		if err != nil {
 			return pr, nil, err
 		}
@@ -439,15 +459,27 @@ func buildRequestAggs(in []*storeapi.AggQuery) []AggQuery {
 	reqAggs := make([]AggQuery, 0, len(in))
 	for _, agg := range in {
 		reqAggs = append(reqAggs, AggQuery{
-			Field:     agg.Field,
-			GroupBy:   agg.GroupBy,
-			Func:      agg.Func.MustAggFunc(),
-			Quantiles: agg.Quantiles,
+			Field:         agg.Field,
+			GroupBy:       agg.GroupBy,
+			ThenBy:        agg.ThenBy,
+			Func:          agg.Func.MustAggFunc(),
+			Quantiles:     agg.Quantiles,
+			QuantileError: agg.QuantileError,
+			IntervalField: agg.IntervalField,
+			Buckets:       bucketsFromProto(agg),
 		})
 	}
 	return reqAggs
 }
 
+func bucketsFromProto(agg *storeapi.AggQuery) seq.NumericBuckets {
+	return seq.NumericBuckets{
+		Ranges:     agg.Ranges,
+		BinWidth:   agg.BinWidth,
+		BinLogBase: agg.BinLogBase,
+	}
+}
+
 func (si *Ingestor) CancelAsyncSearch(ctx context.Context, id string) error {
 	searchStores, err := si.getAsyncSearchStores()
 	if err != nil {
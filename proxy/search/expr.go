@@ -0,0 +1,296 @@
+package search
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	insaneJSON "github.com/ozontech/insane-json"
+
+	"github.com/ozontech/seq-db/parser"
+)
+
+type valueKind int
+
+const (
+	// valueMissing is a value of the field which is not present in the document.
+	valueMissing valueKind = iota
+	valueNull
+	valueString
+	valueNumber
+	valueBool
+)
+
+// value is a result of the expression evaluated on the document.
+type value struct {
+	kind valueKind
+	s    string
+	n    float64
+	b    bool
+}
+
+func stringValue(s string) value {
+	return value{kind: valueString, s: s}
+}
+
+func numberValue(n float64) value {
+	return value{kind: valueNumber, n: n}
+}
+
+func boolValue(b bool) value {
+	return value{kind: valueBool, b: b}
+}
+
+// number returns numeric representation of the value, strings are parsed.
+func (v value) number() (float64, bool) {
+	switch v.kind {
+	case valueNumber:
+		return v.n, true
+	case valueString:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v.s), 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// String returns string representation of the value, numbers are formatted in the shortest form.
+func (v value) String() string {
+	switch v.kind {
+	case valueString:
+		return v.s
+	case valueNumber:
+		return strconv.FormatFloat(v.n, 'f', -1, 64)
+	case valueBool:
+		return strconv.FormatBool(v.b)
+	case valueNull:
+		return "null"
+	}
+	return ""
+}
+
+// digField returns node of the field. Path is looked up as a single key first,
+// so flat keys with dots like "k8s.pod" are found as well as nested objects.
+func digField(root *insaneJSON.Node, path string) *insaneJSON.Node {
+	if node := root.Dig(path); node != nil || !strings.Contains(path, ".") {
+		return node
+	}
+	return root.Dig(strings.Split(path, ".")...)
+}
+
+func nodeValue(node *insaneJSON.Node) value {
+	switch {
+	case node == nil:
+		return value{}
+	case node.IsString():
+		return stringValue(node.AsString())
+	case node.IsNumber():
+		return numberValue(node.AsFloat())
+	case node.IsTrue(), node.IsFalse():
+		return boolValue(node.IsTrue())
+	case node.IsNull():
+		return value{kind: valueNull}
+	}
+	// Objects and arrays are compared as their json.
+	return stringValue(node.EncodeToString())
+}
+
+// evalExpr evaluates the expression on the document.
+func evalExpr(e *parser.Expr, doc *insaneJSON.Node) value {
+	switch e.Kind {
+	case parser.ExprField:
+		return nodeValue(digField(doc, e.Value))
+	case parser.ExprString:
+		return stringValue(e.Value)
+	case parser.ExprNumber:
+		return numberValue(e.Number)
+	case parser.ExprBool:
+		return boolValue(e.Bool)
+	case parser.ExprNull:
+		return value{kind: valueNull}
+	case parser.ExprCompare:
+		return boolValue(compareValues(e.Op, evalExpr(e.Args[0], doc), evalExpr(e.Args[1], doc)))
+	case parser.ExprExists:
+		return boolValue(digField(doc, e.Args[0].Value) != nil)
+	case parser.ExprArith:
+		return arith(e.Op, evalExpr(e.Args[0], doc), evalExpr(e.Args[1], doc))
+	case parser.ExprNeg:
+		if n, ok := evalExpr(e.Args[0], doc).number(); ok {
+			return numberValue(-n)
+		}
+		return value{}
+	case parser.ExprCall:
+		return call(e, doc)
+	case parser.ExprAnd:
+		for _, arg := range e.Args {
+			if !evalExpr(arg, doc).truthy() {
+				return boolValue(false)
+			}
+		}
+		return boolValue(true)
+	case parser.ExprOr:
+		for _, arg := range e.Args {
+			if evalExpr(arg, doc).truthy() {
+				return boolValue(true)
+			}
+		}
+		return boolValue(false)
+	case parser.ExprNot:
+		return boolValue(!evalExpr(e.Args[0], doc).truthy())
+	}
+	panic(fmt.Errorf("BUG: unknown expression kind: %d", e.Kind))
+}
+
+// arith applies arithmetic operator to numbers or numeric strings,
+// the result is missing if any of the operands is not a number or it is not finite, e.g. on division by zero.
+func arith(op string, a, b value) value {
+	an, aOk := a.number()
+	bn, bOk := b.number()
+	if !aOk || !bOk {
+		return value{}
+	}
+
+	var n float64
+	switch op {
+	case "+":
+		n = an + bn
+	case "-":
+		n = an - bn
+	case "*":
+		n = an * bn
+	case "/":
+		n = an / bn
+	case "%":
+		n = math.Mod(an, bn)
+	default:
+		panic(fmt.Errorf("BUG: unknown arithmetic operator: %s", op))
+	}
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		return value{}
+	}
+	return numberValue(n)
+}
+
+// call evaluates the function, missing and null arguments are returned as is by string functions.
+func call(e *parser.Expr, doc *insaneJSON.Node) value {
+	if e.Value == "if" {
+		if evalExpr(e.Args[0], doc).truthy() {
+			return evalExpr(e.Args[1], doc)
+		}
+		return evalExpr(e.Args[2], doc)
+	}
+	if e.Value == "concat" {
+		sb := strings.Builder{}
+		for _, arg := range e.Args {
+			// Missing and null values are concatenated as empty strings.
+			if v := evalExpr(arg, doc); v.kind != valueMissing && v.kind != valueNull {
+				sb.WriteString(v.String())
+			}
+		}
+		return stringValue(sb.String())
+	}
+
+	v := evalExpr(e.Args[0], doc)
+	if v.kind == valueMissing || v.kind == valueNull {
+		return v
+	}
+	switch e.Value {
+	case "lower":
+		return stringValue(strings.ToLower(v.String()))
+	case "upper":
+		return stringValue(strings.ToUpper(v.String()))
+	case "substr":
+		return substr(v.String(), e.Args[1:], doc)
+	}
+	panic(fmt.Errorf("BUG: unknown function: %s", e.Value))
+}
+
+// substr returns substring of s by start and optional length in runes,
+// start and length are clamped to the bounds of the string. Substring is missing if start or length is not finite.
+func substr(s string, args []*parser.Expr, doc *insaneJSON.Node) value {
+	runes := []rune(s)
+	start, ok := evalExpr(args[0], doc).number()
+	if !ok || !isFinite(start) {
+		return value{}
+	}
+	from := clampIndex(start, len(runes))
+	to := len(runes)
+	if len(args) > 1 {
+		length, ok := evalExpr(args[1], doc).number()
+		if !ok || !isFinite(length) {
+			return value{}
+		}
+		to = clampIndex(float64(from)+length, len(runes))
+	}
+	if to < from {
+		return stringValue("")
+	}
+	return stringValue(string(runes[from:to]))
+}
+
+func clampIndex(i float64, n int) int {
+	return int(max(0, min(i, float64(n))))
+}
+
+func isFinite(n float64) bool {
+	return !math.IsNaN(n) && !math.IsInf(n, 0)
+}
+
+func (v value) truthy() bool {
+	switch v.kind {
+	case valueBool:
+		return v.b
+	case valueNumber:
+		return v.n != 0
+	case valueString:
+		return v.s != ""
+	}
+	return false
+}
+
+// compareValues compares values numerically if both of them are numbers or numeric strings,
+// otherwise they are compared as strings. Comparison with a missing field is always false.
+func compareValues(op string, a, b value) bool {
+	if a.kind == valueMissing || b.kind == valueMissing {
+		return false
+	}
+
+	var c int
+	an, aOk := a.number()
+	bn, bOk := b.number()
+	switch {
+	case aOk && bOk:
+		switch {
+		case an < bn:
+			c = -1
+		case an > bn:
+			c = 1
+		}
+	case a.kind == valueNull || b.kind == valueNull:
+		// Null is equal only to null.
+		if op != "=" && op != "!=" {
+			return false
+		}
+		if a.kind != b.kind {
+			c = 1
+		}
+	default:
+		c = strings.Compare(a.String(), b.String())
+	}
+
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	panic(fmt.Errorf("BUG: unknown comparison operator: %s", op))
+}
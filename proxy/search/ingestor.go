@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -31,6 +32,9 @@ type Config struct {
 	WriteStores     *stores.Stores
 	ShuffleReplicas bool
 	MirrorAddr      string
+	// MaxScannedDocs is the number of found documents which can be fetched to apply the pipes
+	// like 'where' within single search request.
+	MaxScannedDocs int
 }
 
 type Ingestor struct {
@@ -79,12 +83,61 @@ func (si *Ingestor) Search(
 		return nil, nil, 0, fmt.Errorf("%w: negative size or offset", consts.ErrInvalidArgument)
 	}
 
+	if from, to := queryTimeRange(string(sr.Q), sr.From, sr.To); from != sr.From || to != sr.To {
+		r := *sr
+		r.From, r.To = from, to
+		sr = &r
+	}
+	if sr.From > sr.To {
+		// '_time' filters don't intersect with the time range of the request, so nothing can be found.
+		return emptyQPR(sr), EmptyDocsStream{}, 0, nil
+	}
+
+	query, err := si.resolveSubqueries(ctx, string(sr.Q), sr.From, sr.To, sr.Explain, tr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if query != string(sr.Q) {
+		r := *sr
+		r.Q = []byte(query)
+		sr = &r
+	}
+
+	pipeline := newDocsPipeline(string(sr.Q))
+	if len(pipeline.sort) > 0 {
+		if len(sr.Sort) > 0 {
+			return nil, nil, 0, fmt.Errorf("%w: sort is set both in request and in 'sort' pipe", consts.ErrInvalidArgument)
+		}
+		r := *sr
+		r.Sort = pipeline.sort
+		sr = &r
+	}
+	storesReq := sr
+	if !pipeline.empty() && sr.ShouldFetch || pipeline.stats != nil {
+		// Documents are filtered after fetch, so stores return all the found ids within the scan budget
+		// and the requested page is collected from the documents which pass the pipes.
+		// 'stats' pipe following the other pipes is calculated on the same documents.
+		r := *sr
+		r.Offset = 0
+		r.Size = si.maxScannedDocs()
+		if pipeline.scansAll() {
+			// One more id is searched to find out whether the found documents exceed the scan budget.
+			r.Size++
+		}
+		if pipeline.tailFirst() && len(sr.Sort) == 0 {
+			// The last documents are the first ones in the reverse order.
+			r.Size = pipeline.tail
+			r.Order = reverseOrder(sr.Order)
+		}
+		storesReq = &r
+	}
+
 	startTime := time.Now()
 	searchStores := si.config.HotStores
 	if si.config.HotReadStores != nil && len(si.config.HotReadStores.Shards) > 0 {
 		searchStores = si.config.HotReadStores
 	}
-	qprs, err := si.searchStores(ctx, sr, searchStores, tr)
+	qprs, err := si.searchStores(ctx, storesReq, searchStores, tr)
 	var partialRespErr error
 
 	if err != nil {
@@ -95,7 +148,7 @@ func (si *Ingestor) Search(
 				return nil, nil, 0, err
 			}
 			metric.SearchColdTotal.Inc()
-			qprs, err = si.searchStores(ctx, sr, si.config.ReadStores, tr)
+			qprs, err = si.searchStores(ctx, storesReq, si.config.ReadStores, tr)
 			if err != nil {
 				metric.SearchColdErrors.Add(1)
 				if errors.Is(err, consts.ErrPartialResponse) {
@@ -120,7 +173,10 @@ func (si *Ingestor) Search(
 		Histogram: make(map[seq.MID]uint64),
 		Aggs:      make([]seq.AggregatableSamples, len(sr.AggQ)),
 	}
-	seq.MergeQPRs(qpr, qprs, sr.Offset+sr.Size, sr.Interval, sr.Order)
+	seq.MergeQPRs(qpr, qprs, storesReq.Offset+storesReq.Size, sr.Interval, storesReq.Order, sr.Sort)
+	if storesReq.Order != sr.Order {
+		slices.Reverse(qpr.IDs)
+	}
 	mergeDuration := time.Since(t)
 	if len(qpr.Errors) > 0 {
 		for _, errSource := range qpr.Errors {
@@ -133,24 +189,47 @@ func (si *Ingestor) Search(
 		}
 	}
 
-	var size int
-	qpr.IDs, size = paginateIDs(qpr.IDs, sr.Offset, sr.Size)
-	ids := qpr.IDs
-
 	t = time.Now()
 	docsStream = EmptyDocsStream{}
-	if sr.ShouldFetch && size > 0 {
+	if storesReq != sr {
 		if util.IsCancelled(ctx) {
 			return nil, nil, 0, ctx.Err()
 		}
-		metric.DocumentsRequested.Observe(float64(len(ids)))
+		if pipeline.scansAll() && len(qpr.IDs) > si.maxScannedDocs() {
+			// The pipes are applied to the first found documents only, so the result is incomplete.
+			qpr.IDs = qpr.IDs[:si.maxScannedDocs()]
+			if partialRespErr == nil {
+				partialRespErr = fmt.Errorf("%w: pipes are applied to the first %d found documents only",
+					consts.ErrPartialResponse, si.maxScannedDocs())
+			}
+		}
+		metric.DocumentsRequested.Observe(float64(len(qpr.IDs)))
 
-		fieldsFilter := tryParseFieldsFilter(string(sr.Q))
-		docsStream, err = si.FetchDocsStream(ctx, ids, sr.Explain, fieldsFilter)
+		qpr.IDs, docsStream, err = si.fetchPiped(ctx, qpr.IDs, sr.Offset, sr.Size, sr.Explain, pipeline)
 		if err != nil {
 			return nil, nil, 0, err
 		}
+		if pipeline.stats != nil {
+			// Aggregations of 'stats' pipe follow the ones of the request.
+			qpr.Aggs = append(qpr.Aggs, pipeline.stats.aggs...)
+		}
+	} else {
+		var size int
+		qpr.IDs, size = paginateIDs(qpr.IDs, sr.Offset, sr.Size)
+
+		if sr.ShouldFetch && size > 0 {
+			if util.IsCancelled(ctx) {
+				return nil, nil, 0, ctx.Err()
+			}
+			metric.DocumentsRequested.Observe(float64(len(qpr.IDs)))
+
+			docsStream, err = si.FetchDocsStream(ctx, qpr.IDs, sr.Explain, pipeline.fieldsFilter)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+		}
 	}
+	ids := qpr.IDs
 
 	fetchDuration := time.Since(t)
 	overallDuration = time.Since(startTime)
@@ -171,27 +250,37 @@ func (si *Ingestor) Search(
 	return qpr, docsStream, overallDuration, partialRespErr
 }
 
-// tryParseFieldsFilter tries to parse seq-ql query to extract fields/remove pipe.
-// If it fails, returns empty filter which means no filtering.
-func tryParseFieldsFilter(query string) FetchFieldsFilter {
-	// Parse seq-ql query to extract fields/remove pipe.
+// queryTimeRange intersects the time range of the request with the one set by `_time` filters of seq-ql query.
+// Stores don't evaluate the filters, so relative dates like "now-15m" are resolved once on proxy.
+// The range is returned as is if the query can't be parsed, stores report the error then.
+func queryTimeRange(query string, from, to seq.MID) (seq.MID, seq.MID) {
 	q, err := parser.ParseSeqQL(query, nil)
 	if err != nil {
-		logger.Error("failed to parse query on fetch stage", zap.String("query", query), zap.Error(err))
-		return FetchFieldsFilter{}
+		return from, to
 	}
-	// Find first 'fields' or 'remove' pipe since only one of them is allowed.
-	for _, pipe := range q.Pipes {
-		p, ok := pipe.(*parser.PipeFields)
-		if !ok {
-			continue
-		}
-		return FetchFieldsFilter{
-			Fields:    p.Fields,
-			AllowList: !p.Except,
-		}
+	return q.Time.Intersect(from, to)
+}
+
+// emptyQPR returns the result of the search which finds no documents,
+// it has the aggregations of the request followed by the ones of 'stats' pipe.
+func emptyQPR(sr *SearchRequest) *seq.QPR {
+	qpr := &seq.QPR{
+		Histogram: make(map[seq.MID]uint64),
+		Aggs:      make([]seq.AggregatableSamples, len(sr.AggQ)),
+	}
+	pipeline := newDocsPipeline(string(sr.Q))
+	defer pipeline.release()
+	if pipeline.stats != nil {
+		qpr.Aggs = append(qpr.Aggs, pipeline.stats.aggs...)
+	}
+	return qpr
+}
+
+func reverseOrder(order seq.DocsOrder) seq.DocsOrder {
+	if order.IsReverse() {
+		return seq.DocsOrderDesc
 	}
-	return FetchFieldsFilter{}
+	return seq.DocsOrderAsc
 }
 
 func paginateIDs(ids seq.IDSources, offset, size int) (seq.IDSources, int) {
@@ -209,6 +298,13 @@ func paginateIDs(ids seq.IDSources, offset, size int) (seq.IDSources, int) {
 	return ids, size
 }
 
+func (si *Ingestor) maxScannedDocs() int {
+	if si.config.MaxScannedDocs > 0 {
+		return si.config.MaxScannedDocs
+	}
+	return defaultMaxScannedDocs
+}
+
 func (si *Ingestor) singleDocsStream(ctx context.Context, explain bool, source uint64, ids []seq.IDSource, fields FetchFieldsFilter) (DocsIterator, error) {
 	startTime := time.Now()
 	host, has := si.clientBySource[source]
@@ -246,13 +342,17 @@ func groupIDsBySource(ids []seq.IDSource) map[uint64][]seq.IDSource {
 
 // lessFuncPosBased returns compare function based on initial order of ids
 func lessFuncPosBased(ids []seq.IDSource) func(a, b seq.IDSource) bool {
-	positions := make(map[seq.IDSource]uint32, len(ids))
+	type idWithSource struct {
+		id     seq.ID
+		source uint64
+	}
+	positions := make(map[idWithSource]uint32, len(ids))
 	for i, id := range ids {
-		positions[seq.IDSource{ID: id.ID, Source: id.Source}] = uint32(i)
+		positions[idWithSource{id: id.ID, source: id.Source}] = uint32(i)
 	}
 	return func(a, b seq.IDSource) bool {
-		aPos, aOk := positions[a]
-		bPos, bOk := positions[b]
+		aPos, aOk := positions[idWithSource{id: a.ID, source: a.Source}]
+		bPos, bOk := positions[idWithSource{id: b.ID, source: b.Source}]
 		if !aOk || !bOk {
 			if !aOk && !bOk {
 				panic("attempt to compare unknown IDSources")
@@ -467,6 +567,42 @@ func (si *Ingestor) searchStores(
 	return qprs, nil
 }
 
+func sortValuesFromProto(values []*storeapi.SearchResponse_SortValue) []seq.SortValue {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]seq.SortValue, len(values))
+	for i, v := range values {
+		res[i] = seq.SortValue{Value: string(v.Value), Missing: v.Missing}
+	}
+	return res
+}
+
+// sketchFromProto sets the quantile and cardinality sketches of the histogram,
+// the quantile one is built from the raw samples sent by the stores which do not support the sketch.
+func sketchFromProto(dst *seq.SamplesContainer, pbhist *storeapi.SearchResponse_Histogram) error {
+	if len(pbhist.Cardinality) > 0 {
+		cardinality, _, err := seq.UnmarshalCardinalitySketch(pbhist.Cardinality)
+		if err != nil {
+			return fmt.Errorf("can't unmarshal cardinality sketch: %w", err)
+		}
+		dst.Cardinality = cardinality
+	}
+
+	if len(pbhist.Sketch) > 0 {
+		sketch, _, err := seq.UnmarshalQuantileSketch(pbhist.Sketch)
+		if err != nil {
+			return fmt.Errorf("can't unmarshal quantile sketch: %w", err)
+		}
+		dst.Sketch = sketch
+		return nil
+	}
+	for _, v := range pbhist.Samples {
+		dst.InsertSampleNTimes(v, 1, seq.DefaultQuantileError)
+	}
+	return nil
+}
+
 func responseToQPR(resp *storeapi.SearchResponse, source uint64, explain bool) *seq.QPR {
 	if explain {
 		logger.Info("received from seq-db",
@@ -482,8 +618,9 @@ func responseToQPR(resp *storeapi.SearchResponse, source uint64, explain bool) *
 				MID: seq.MID(idSource.Id.Mid),
 				RID: seq.RID(idSource.Id.Rid),
 			},
-			Source: source,
-			Hint:   idSource.Hint,
+			Source:     source,
+			Hint:       idSource.Hint,
+			SortValues: sortValuesFromProto(idSource.SortValues),
 		})
 	}
 
@@ -492,6 +629,14 @@ func responseToQPR(resp *storeapi.SearchResponse, source uint64, explain bool) *
 		hist[seq.MID(k)] = v
 	}
 
+	errSources := make([]seq.ErrorSource, 0, len(resp.Errors))
+	for _, err := range resp.Errors {
+		errSources = append(errSources, seq.ErrorSource{
+			ErrStr: err,
+			Source: source,
+		})
+	}
+
 	aggs := make([]seq.AggregatableSamples, len(resp.Aggs))
 	for i, agg := range resp.Aggs {
 		from := agg.Timeseries
@@ -505,14 +650,20 @@ func responseToQPR(resp *storeapi.SearchResponse, source uint64, explain bool) *
 				Token: bin.Label,
 			}
 
-			to[tbin] = &seq.SamplesContainer{
+			samples := &seq.SamplesContainer{
 				Min:       pbhist.Min,
 				Max:       pbhist.Max,
 				Sum:       pbhist.Sum,
 				Total:     pbhist.Total,
-				Samples:   pbhist.Samples,
 				NotExists: pbhist.NotExists,
 			}
+			if err := sketchFromProto(samples, pbhist); err != nil {
+				errSources = append(errSources, seq.ErrorSource{
+					ErrStr: err.Error(),
+					Source: source,
+				})
+			}
+			to[tbin] = samples
 		}
 
 		aggs[i] = seq.AggregatableSamples{
@@ -521,14 +672,6 @@ func responseToQPR(resp *storeapi.SearchResponse, source uint64, explain bool) *
 		}
 	}
 
-	errSources := make([]seq.ErrorSource, 0, len(resp.Errors))
-	for _, err := range resp.Errors {
-		errSources = append(errSources, seq.ErrorSource{
-			ErrStr: err,
-			Source: source,
-		})
-	}
-
 	return &seq.QPR{
 		IDs:       ids,
 		Histogram: hist,
@@ -237,3 +237,62 @@ func zeroDurationsInSpan(s *querytracer.Span) *querytracer.Span {
 	}
 	return s
 }
+
+func TestSketchFromProto(t *testing.T) {
+	sketch := seq.NewQuantileSketch(0.05)
+	sketch.Add(10, 3)
+
+	s := &seq.SamplesContainer{}
+	assert.NoError(t, sketchFromProto(s, &storeapi.SearchResponse_Histogram{Sketch: sketch.Append(nil)}))
+	assert.Equal(t, sketch, s.Sketch)
+
+	// Samples are sent by the stores which do not support the sketch.
+	s = &seq.SamplesContainer{}
+	assert.NoError(t, sketchFromProto(s, &storeapi.SearchResponse_Histogram{Samples: []float64{10, 10, 10}}))
+	assert.Equal(t, int64(3), s.Sketch.Count())
+	assert.Equal(t, seq.DefaultQuantileError, s.Sketch.RelativeError())
+
+	s = &seq.SamplesContainer{}
+	assert.Error(t, sketchFromProto(s, &storeapi.SearchResponse_Histogram{Sketch: []byte{1}}))
+	assert.Nil(t, s.Sketch)
+
+	cardinality := seq.NewCardinalitySketch()
+	cardinality.Add(seq.HashValue("a"))
+	cardinality.Add(seq.HashValue("b"))
+	s = &seq.SamplesContainer{}
+	assert.NoError(t, sketchFromProto(s, &storeapi.SearchResponse_Histogram{Cardinality: cardinality.Append(nil)}))
+	assert.Equal(t, float64(2), s.Cardinality.Estimate())
+	assert.Nil(t, s.Sketch)
+
+	s = &seq.SamplesContainer{}
+	assert.Error(t, sketchFromProto(s, &storeapi.SearchResponse_Histogram{Cardinality: []byte{2}}))
+	assert.Nil(t, s.Cardinality)
+}
+
+func TestSearchDisjointTimeRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// Stores are not queried, since nothing can be found.
+	store := mock.NewMockStoreApiClient(ctrl)
+
+	searchIngestor := NewIngestor(
+		Config{HotStores: &stores.Stores{Shards: [][]string{{"store1"}}}},
+		map[string]storeapi.StoreApiClient{"store1": store},
+	)
+
+	now := time.Now()
+	qpr, docsStream, _, err := searchIngestor.Search(context.Background(), &SearchRequest{
+		Q:           []byte(`_time:>now-1h | where level = "error" | stats count() by service`),
+		From:        seq.TimeToMID(now.Add(-24 * time.Hour)),
+		To:          seq.TimeToMID(now.Add(-3 * time.Hour)),
+		Size:        10,
+		ShouldFetch: true,
+		AggQ:        []AggQuery{{GroupBy: "service", Func: seq.AggFuncCount}},
+	}, querytracer.New(false, "test"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), qpr.Total)
+	assert.Empty(t, qpr.IDs)
+	assert.Empty(t, qpr.Histogram)
+	// Aggregations of the request are followed by the one of 'stats' pipe.
+	assert.Len(t, qpr.Aggs, 2)
+	assert.Empty(t, ReadAll(docsStream))
+}
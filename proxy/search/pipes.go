@@ -0,0 +1,442 @@
+package search
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strconv"
+
+	insaneJSON "github.com/ozontech/insane-json"
+	"go.uber.org/zap"
+
+	"github.com/ozontech/seq-db/logger"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/util"
+)
+
+const (
+	// defaultMaxScannedDocs is the number of found documents the pipes can fetch and scan
+	// within single search request, unless it is set in the config.
+	defaultMaxScannedDocs = 10000
+
+	// minPipeFetchBatch is the minimal number of documents fetched at once to apply the pipes.
+	minPipeFetchBatch = 100
+)
+
+// docStage is a pipe applied to each fetched document.
+type docStage interface {
+	// process returns false if the document must be skipped.
+	process(doc *insaneJSON.Root) bool
+}
+
+// docsPipeline applies the pipes of seq-ql query which are evaluated on the proxy to the fetched documents.
+type docsPipeline struct {
+	// fieldsFilter is the 'fields' pipe applied by stores on fetch.
+	fieldsFilter FetchFieldsFilter
+	stages       []docStage
+	// tail is the number of the last documents kept by 'tail' pipe, the documents are collected
+	// from all the scanned ones, then tailStages are applied to them.
+	tail       int
+	tailStages []docStage
+	// sort is the 'sort' pipe applied by stores on search.
+	sort []seq.SortField
+	// stats is the 'stats' (or 'top', 'timechart') pipe following the other pipes, so it is calculated on the fetched documents
+	// instead of stores. It is the last stage which consumes all the documents.
+	stats *statsStage
+	// modifies is true if the stages change documents, so they must be encoded back.
+	modifies bool
+
+	root *insaneJSON.Root
+}
+
+// newDocsPipeline parses seq-ql query to extract its pipes.
+// If it fails, returns empty pipeline which means no processing.
+func newDocsPipeline(query string) *docsPipeline {
+	p := &docsPipeline{}
+	q, err := parser.ParseSeqQL(query, nil)
+	if err != nil {
+		logger.Error("failed to parse query on fetch stage", zap.String("query", query), zap.Error(err))
+		return p
+	}
+	// Stages after 'tail' pipe are applied to the kept documents.
+	stages := &p.stages
+	for i, pipe := range q.Pipes {
+		switch pipe := pipe.(type) {
+		case *parser.PipeFields:
+			ff := FetchFieldsFilter{
+				Fields:    pipe.Fields,
+				AllowList: !pipe.Except,
+			}
+			if p.empty() {
+				// Nothing is evaluated before the pipe, so stores can filter fields of the documents.
+				p.fieldsFilter = ff
+				continue
+			}
+			*stages = append(*stages, fieldsStage{filter: ff})
+			p.modifies = true
+		case *parser.PipeWhere:
+			*stages = append(*stages, whereStage{cond: pipe.Cond})
+		case *parser.PipeHead:
+			*stages = append(*stages, &headStage{limit: pipe.Limit})
+		case *parser.PipeDedup:
+			*stages = append(*stages, &dedupStage{fields: pipe.Fields, seen: make(map[string]struct{})})
+		case *parser.PipeRename:
+			*stages = append(*stages, renameStage{fields: pipe.Fields})
+			p.modifies = true
+		case *parser.PipeEval:
+			*stages = append(*stages, evalStage{fields: pipe.Fields})
+			p.modifies = true
+		case *parser.PipeExtract:
+			*stages = append(*stages, &extractStage{extract: pipe})
+			p.modifies = true
+		case *parser.PipeTail:
+			p.tail = pipe.Limit
+			stages = &p.tailStages
+		case *parser.PipeSort:
+			p.sort = pipe.Fields
+		case *parser.PipeStats:
+			if i == 0 {
+				// Stores calculate it as aggregations.
+				continue
+			}
+			p.stats = newStatsStage(pipe)
+			*stages = append(*stages, p.stats)
+		case *parser.PipeTop:
+			if i == 0 {
+				continue
+			}
+			p.stats = newStatsStage(pipe.Stats())
+			*stages = append(*stages, p.stats)
+		case *parser.PipeTimechart:
+			if i == 0 {
+				continue
+			}
+			p.stats = newStatsStage(pipe.Stats())
+			p.stats.interval = seq.MID(pipe.Span.Milliseconds())
+			*stages = append(*stages, p.stats)
+		}
+	}
+	return p
+}
+
+// empty returns true if the documents are returned as they are fetched.
+func (p *docsPipeline) empty() bool {
+	return len(p.stages) == 0 && p.tail == 0
+}
+
+// tailFirst returns true if 'tail' pipe is applied to all the found documents,
+// so the last of them can be searched in the reverse order.
+func (p *docsPipeline) tailFirst() bool {
+	return p.tail > 0 && len(p.stages) == 0
+}
+
+// scansAll returns true if the result depends on all the found documents,
+// like the counts of 'stats' pipe or the last documents of 'tail' pipe following the other pipes.
+func (p *docsPipeline) scansAll() bool {
+	return p.stats != nil || p.tail > 0 && !p.tailFirst()
+}
+
+// exhausted returns true if no more documents can pass the stages, e.g. because of 'head' pipe.
+func (p *docsPipeline) exhausted() bool {
+	for _, s := range p.stages {
+		if h, ok := s.(*headStage); ok && h.passed >= h.limit {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns false if the document is skipped by the pipeline.
+func (p *docsPipeline) apply(doc StreamingDoc) (StreamingDoc, bool) {
+	return p.applyStages(doc, p.stages)
+}
+
+// applyTail applies the stages following 'tail' pipe to the kept documents.
+func (p *docsPipeline) applyTail(docs []StreamingDoc) []StreamingDoc {
+	if len(p.tailStages) == 0 {
+		return docs
+	}
+	res := docs[:0]
+	for _, doc := range docs {
+		if doc, ok := p.applyStages(doc, p.tailStages); ok {
+			res = append(res, doc)
+		}
+	}
+	return res
+}
+
+func (p *docsPipeline) applyStages(doc StreamingDoc, stages []docStage) (StreamingDoc, bool) {
+	if len(stages) == 0 {
+		return doc, true
+	}
+	if p.root == nil {
+		p.root = insaneJSON.Spawn()
+	}
+	if err := p.root.DecodeBytes(doc.Data); err != nil {
+		logger.Error("error decoding doc in pipes", zap.String("doc_id", doc.ID.String()), zap.Error(err))
+		return doc, false
+	}
+	if p.stats != nil {
+		p.stats.mid = doc.ID.MID
+	}
+	for _, s := range stages {
+		if !s.process(p.root) {
+			return doc, false
+		}
+	}
+	if p.modifies {
+		doc.Data = p.root.Encode(nil)
+	}
+	return doc, true
+}
+
+func (p *docsPipeline) release() {
+	if p.root != nil {
+		insaneJSON.Release(p.root)
+		p.root = nil
+	}
+}
+
+type whereStage struct {
+	cond *parser.Expr
+}
+
+func (s whereStage) process(doc *insaneJSON.Root) bool {
+	return evalExpr(s.cond, doc.Node).truthy()
+}
+
+type headStage struct {
+	limit  int
+	passed int
+}
+
+func (s *headStage) process(*insaneJSON.Root) bool {
+	if s.passed >= s.limit {
+		return false
+	}
+	s.passed++
+	return true
+}
+
+type dedupStage struct {
+	fields []string
+	seen   map[string]struct{}
+	key    []byte
+}
+
+// process skips the document if the values of the fields were seen before,
+// missing field is considered as a distinct value.
+func (s *dedupStage) process(doc *insaneJSON.Root) bool {
+	s.key = s.key[:0]
+	for _, field := range s.fields {
+		v := nodeValue(digField(doc.Node, field))
+		// Kind is a part of the key, so string "1" and number 1 are different values.
+		s.key = append(s.key, byte(v.kind))
+		s.key = strconv.AppendQuote(s.key, v.String())
+	}
+	if _, ok := s.seen[string(s.key)]; ok {
+		return false
+	}
+	s.seen[string(s.key)] = struct{}{}
+	return true
+}
+
+type renameStage struct {
+	fields []parser.RenameField
+}
+
+// process renames the fields, the existing field with the new name is replaced.
+func (s renameStage) process(doc *insaneJSON.Root) bool {
+	if !doc.IsObject() {
+		return true
+	}
+	for _, f := range s.fields {
+		node := digField(doc.Node, f.From)
+		if node == nil || f.From == f.To {
+			continue
+		}
+		if field := doc.DigField(f.From); field != nil {
+			// Top-level field is renamed in place.
+			digField(doc.Node, f.To).Suicide()
+			field.MutateToField(f.To)
+			continue
+		}
+		// Nested field is moved to the top level.
+		json := node.EncodeToString()
+		node.Suicide()
+		digField(doc.Node, f.To).Suicide()
+		doc.AddField(f.To).MutateToJSON(doc, json)
+	}
+	return true
+}
+
+type evalStage struct {
+	fields []parser.EvalField
+}
+
+// process sets the fields to the values of the expressions, the fields which do not exist are added
+// to the top level. Missing values are set as null.
+func (s evalStage) process(doc *insaneJSON.Root) bool {
+	if !doc.IsObject() {
+		return true
+	}
+	for _, f := range s.fields {
+		v := evalExpr(f.Expr, doc.Node)
+		node := setField(doc, f.Field)
+		if f.Expr.Kind == parser.ExprField {
+			// Objects and arrays are evaluated as json strings, but copied as they are.
+			if src := digField(doc.Node, f.Expr.Value); src.IsObject() || src.IsArray() {
+				node.MutateToJSON(doc, src.EncodeToString())
+				continue
+			}
+		}
+		switch v.kind {
+		case valueString:
+			node.MutateToString(v.s)
+		case valueNumber:
+			node.MutateToFloat(v.n)
+		case valueBool:
+			node.MutateToBool(v.b)
+		default:
+			node.MutateToNull()
+		}
+	}
+	return true
+}
+
+type extractStage struct {
+	extract *parser.PipeExtract
+	values  []string
+}
+
+// process sets the fields to the values extracted from the text, the document is not changed if it does not match.
+func (s *extractStage) process(doc *insaneJSON.Root) bool {
+	if !doc.IsObject() {
+		return true
+	}
+	v := nodeValue(digField(doc.Node, s.extract.From))
+	if v.kind == valueMissing || v.kind == valueNull {
+		return true
+	}
+	values, ok := s.extract.Match(v.String(), s.values)
+	s.values = values
+	if !ok {
+		return true
+	}
+	for i, field := range s.extract.Fields {
+		if field != "" {
+			setField(doc, field).MutateToString(values[i])
+		}
+	}
+	return true
+}
+
+// setField returns node of the field to set its value, the field which does not exist is added to the top level.
+func setField(doc *insaneJSON.Root, field string) *insaneJSON.Node {
+	if node := digField(doc.Node, field); node != nil {
+		return node
+	}
+	return doc.AddField(field)
+}
+
+type fieldsStage struct {
+	filter FetchFieldsFilter
+}
+
+func (s fieldsStage) process(doc *insaneJSON.Root) bool {
+	if !doc.IsObject() {
+		return true
+	}
+	if !s.filter.AllowList {
+		for _, field := range s.filter.Fields {
+			doc.Dig(field).Suicide()
+		}
+		return true
+	}
+	// Fields are collected first, since Suicide invalidates AsFields.
+	var remove []*insaneJSON.Node
+	for _, field := range doc.AsFields() {
+		if !slices.Contains(s.filter.Fields, field.AsString()) {
+			remove = append(remove, field.AsFieldValue())
+		}
+	}
+	for _, n := range remove {
+		n.Suicide()
+	}
+	return true
+}
+
+// fetchPiped fetches documents of the ids in batches and applies the pipeline to them,
+// until offset+size documents pass the pipeline or all the ids are scanned.
+// It returns ids and documents of the requested page.
+func (si *Ingestor) fetchPiped(
+	ctx context.Context,
+	ids seq.IDSources,
+	offset, size int,
+	explain bool,
+	p *docsPipeline,
+) (seq.IDSources, DocsIterator, error) {
+	defer p.release()
+
+	need := offset + size
+	batch := max(need, minPipeFetchBatch)
+	scanned := 0
+
+	var docs []StreamingDoc
+	// All the ids are scanned to find the last documents for 'tail' pipe and to calculate 'stats' pipe.
+	for len(ids) > 0 && (p.tail > 0 || p.stats != nil || len(docs) < need) && !p.exhausted() {
+		if util.IsCancelled(ctx) {
+			return nil, nil, ctx.Err()
+		}
+		n := min(batch, len(ids))
+		stream, err := si.FetchDocsStream(ctx, ids[:n], explain, p.fieldsFilter)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = ids[n:]
+		scanned += n
+
+		for doc, err := stream.Next(); err == nil; doc, err = stream.Next() {
+			if doc.Empty() {
+				continue
+			}
+			if doc, ok := p.apply(doc); ok {
+				docs = append(docs, doc)
+			}
+		}
+		if p.tail > 0 && len(docs) > p.tail {
+			docs = slices.Delete(docs, 0, len(docs)-p.tail)
+		}
+	}
+	if p.tail > 0 {
+		docs = p.applyTail(docs)
+	}
+
+	if explain {
+		logger.Info("pipes result",
+			zap.Int("scanned", scanned),
+			zap.Int("passed", len(docs)),
+		)
+	}
+
+	docs = docs[min(offset, len(docs)):min(need, len(docs))]
+	pageIDs := make(seq.IDSources, 0, len(docs))
+	for _, doc := range docs {
+		pageIDs = append(pageIDs, doc.IDSource())
+	}
+	return pageIDs, &sliceDocsIterator{docs: docs}, nil
+}
+
+type sliceDocsIterator struct {
+	docs []StreamingDoc
+}
+
+func (s *sliceDocsIterator) Next() (StreamingDoc, error) {
+	if len(s.docs) == 0 {
+		return StreamingDoc{}, io.EOF
+	}
+	doc := s.docs[0]
+	s.docs = s.docs[1:]
+	return doc, nil
+}
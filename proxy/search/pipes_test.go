@@ -0,0 +1,576 @@
+package search
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	insaneJSON "github.com/ozontech/insane-json"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/pkg/storeapi"
+	"github.com/ozontech/seq-db/proxy/search/mock"
+	"github.com/ozontech/seq-db/proxy/stores"
+	"github.com/ozontech/seq-db/querytracer"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/storage"
+)
+
+func TestEvalWhere(t *testing.T) {
+	doc, err := insaneJSON.DecodeString(`{
+		"payload": {"status": "FAILED"},
+		"k8s.pod": "api-1",
+		"latency_ms": 700,
+		"code": "404",
+		"ok": false,
+		"err": null,
+		"tags": ["a", "b"]
+	}`)
+	require.NoError(t, err)
+	defer insaneJSON.Release(doc)
+
+	test := func(cond string, expected bool) {
+		t.Helper()
+		q, err := parser.ParseSeqQL("* | where "+cond, nil)
+		require.NoError(t, err)
+		where := q.Pipes[0].(*parser.PipeWhere)
+		require.Equal(t, expected, evalExpr(where.Cond, doc.Node).truthy(), cond)
+	}
+
+	test(`payload.status = "FAILED" and latency_ms > 500`, true)
+	test(`payload.status = "failed"`, false)
+	test(`k8s.pod = "api-1"`, true)
+	test(`latency_ms >= 700 and latency_ms <= 700`, true)
+	test(`latency_ms != 700`, false)
+	test(`latency_ms < 1000.5`, true)
+
+	// Numeric strings are compared as numbers.
+	test(`code > 99`, true)
+	test(`code = 404`, true)
+	test(`code > "5"`, true)
+
+	// Strings are compared lexicographically.
+	test(`payload.status > "ABC"`, true)
+	test(`payload.status < "ABC"`, false)
+
+	test(`ok = false`, true)
+	test(`ok = "false"`, true)
+	test(`err = null`, true)
+	test(`ok = null`, false)
+	test(`err > null`, false)
+	test(`tags = '["a","b"]'`, true)
+
+	// Comparison with a missing field is false.
+	test(`missing = 1`, false)
+	test(`missing != 1`, false)
+	test(`not missing = 1`, true)
+
+	test(`exists(payload.status)`, true)
+	test(`exists(err)`, true)
+	test(`exists(payload.code)`, false)
+	test(`not exists(missing) and (ok = true or latency_ms > 1)`, true)
+	test(`ok = true or missing = 1`, false)
+
+	test(`latency_ms / 1000 = 0.7`, true)
+	test(`latency_ms % 7 = 0 and -latency_ms < 0`, true)
+	test(`code + 1 = 405`, true)
+	test(`payload.status + 1 = 1`, false)
+	test(`latency_ms / 0 = null`, false)
+	test(`lower(payload.status) = "failed" and upper(k8s.pod) = "API-1"`, true)
+	test(`substr(k8s.pod, 1, 2) = "pi" and substr(k8s.pod, 4) = "1" and substr(k8s.pod, -5, 100) = "api-1"`, true)
+	test(`concat(k8s.pod, ":", code, missing, err) = "api-1:404"`, true)
+	test(`if(ok, missing, latency_ms) = 700`, true)
+}
+
+func TestEvalExpr(t *testing.T) {
+	doc, err := insaneJSON.DecodeString(`{"s":"Привет, мир","n":7,"f":1.5,"not_num":"NaN","inf_num":"-Inf"}`)
+	require.NoError(t, err)
+	defer insaneJSON.Release(doc)
+
+	test := func(expr string, expected value) {
+		t.Helper()
+		q, err := parser.ParseSeqQL("* | eval x = "+expr, nil)
+		require.NoError(t, err)
+		eval := q.Pipes[0].(*parser.PipeEval)
+		require.Equal(t, expected, evalExpr(eval.Fields[0].Expr, doc.Node), expr)
+	}
+
+	test(`n * 2 + f`, numberValue(15.5))
+	test(`n - f * 2`, numberValue(4))
+	test(`(n - f) * 2`, numberValue(11))
+	test(`n % 4`, numberValue(3))
+	test(`-n`, numberValue(-7))
+	test(`n / 0`, value{})
+	test(`n + missing`, value{})
+	test(`s + 1`, value{})
+	test(`n > 5`, boolValue(true))
+	test(`lower(s)`, stringValue("привет, мир"))
+	test(`upper(missing)`, value{})
+	test(`substr(s, 8)`, stringValue("мир"))
+	test(`substr(s, 0, 6)`, stringValue("Привет"))
+	test(`substr(s, 3, -1)`, stringValue(""))
+	test(`substr(s, "x")`, value{})
+	test(`substr(s, not_num)`, value{})
+	test(`substr(s, inf_num)`, value{})
+	test(`substr(s, 0, not_num)`, value{})
+	test(`substr(s, 0, inf_num)`, value{})
+	test(`concat("n=", n, ", f=", f)`, stringValue("n=7, f=1.5"))
+	test(`if(n > 5, "big", "small")`, stringValue("big"))
+	test(`if(missing, 1, null)`, value{kind: valueNull})
+}
+
+func TestDocsPipeline(t *testing.T) {
+	test := func(query string, docs, expected []string) {
+		t.Helper()
+		p := newDocsPipeline(query)
+		defer p.release()
+
+		var res []string
+		for _, doc := range docs {
+			if d, ok := p.apply(StreamingDoc{Data: []byte(doc)}); ok {
+				res = append(res, string(d.Data))
+			}
+		}
+		require.Equal(t, expected, res)
+	}
+
+	docs := []string{
+		`{"level":"error","message":"a","latency_ms":10}`,
+		`{"level":"info","message":"b","latency_ms":1000}`,
+		`{"level":"error","message":"c","latency_ms":1000}`,
+		`not a json`,
+	}
+
+	test(`* | where level = "error"`, docs, []string{docs[0], docs[2]})
+	test(`* | where level = "error" and latency_ms > 100`, docs, []string{docs[2]})
+	test(`* | where level = "debug"`, docs, nil)
+
+	// Fields pipe after 'where' is applied on the proxy.
+	test(`* | where latency_ms > 100 | fields message`, docs, []string{`{"message":"b"}`, `{"message":"c"}`})
+	test(`* | where latency_ms > 100 | fields except level, latency_ms`, docs, []string{`{"message":"b"}`, `{"message":"c"}`})
+
+	// 'head' and 'dedup' keep the state between documents.
+	test(`* | head 2`, docs, []string{docs[0], docs[1]})
+	test(`* | where latency_ms > 100 | head 1`, docs, []string{docs[1]})
+	test(`* | dedup level`, docs, []string{docs[0], docs[1]})
+	test(`* | dedup latency_ms, level`, docs, []string{docs[0], docs[1], docs[2]})
+	test(`* | dedup missing`, docs, []string{docs[0]})
+	test(`* | dedup level | head 1 | fields message`, docs, []string{`{"message":"a"}`})
+
+	// 'rename' and 'eval' modify documents.
+	test(`* | rename message as msg | fields msg`, docs, []string{`{"msg":"a"}`, `{"msg":"b"}`, `{"msg":"c"}`})
+	test(`* | head 1 | rename message as level`, docs, []string{`{"latency_ms":10,"level":"a"}`})
+	test(
+		`* | eval latency_s = latency_ms / 1000, is_slow = latency_ms > 500 | where is_slow = true | fields message, latency_s`,
+		docs,
+		[]string{`{"latency_s":1,"message":"b"}`, `{"latency_s":1,"message":"c"}`},
+	)
+	test(`* | eval message = upper(message), x = missing | head 1`, docs, []string{`{"level":"error","message":"A","latency_ms":10,"x":null}`})
+	test(
+		`* | rename a.b as c, d as d.e | eval a = c`,
+		[]string{`{"a":{"b":[1,2]},"c":1,"d":"x"}`},
+		[]string{`{"a":[1,2],"d.e":"x","c":[1,2]}`},
+	)
+
+	// 'extract' creates fields used by the following pipes.
+	logs := []string{
+		`{"message":"user=bob took 15ms"}`,
+		`{"message":"user=alice took 700ms","user":"x"}`,
+		`{"message":"health check"}`,
+		`{"text":{"line":"user=eve took 1ms"}}`,
+	}
+	test(
+		`* | extract "user=<user> took <dur>ms" | where dur > 100 | fields user, dur`,
+		logs,
+		[]string{`{"dur":"700","user":"alice"}`},
+	)
+	test(`* | extract "user=<user> " from text.line | where exists(user)`, logs[3:], []string{`{"text":{"line":"user=eve took 1ms"},"user":"eve"}`})
+	test(`* | extract re("took (?P<ms>\\d+)") | where ms < 100 | fields ms`, logs, []string{`{"ms":"15"}`})
+	test(`* | extract "took <ms>ms" | fields ms`, logs, []string{`{"ms":"15"}`, `{"ms":"700"}`, `{}`, `{}`})
+
+	p := newDocsPipeline(`* | head 1`)
+	require.False(t, p.exhausted())
+	p.apply(StreamingDoc{Data: []byte(docs[0])})
+	require.True(t, p.exhausted())
+
+	// Stages after 'tail' are applied to the kept documents.
+	p = newDocsPipeline(`* | where level = "error" | tail 1 | fields message`)
+	require.False(t, p.tailFirst())
+	require.Equal(t, 1, p.tail)
+	require.Len(t, p.stages, 1)
+	require.Len(t, p.tailStages, 1)
+	tail := p.applyTail([]StreamingDoc{{Data: []byte(docs[2])}})
+	require.Len(t, tail, 1)
+	require.Equal(t, `{"message":"c"}`, string(tail[0].Data))
+
+	p = newDocsPipeline(`* | fields message | tail 5`)
+	require.True(t, p.tailFirst())
+	require.False(t, p.empty())
+
+	p = newDocsPipeline(`* | fields message | where message = "a"`)
+	require.Equal(t, FetchFieldsFilter{Fields: []string{"message"}, AllowList: true}, p.fieldsFilter)
+	require.False(t, p.empty())
+
+	p = newDocsPipeline(`* | fields message`)
+	require.True(t, p.empty())
+}
+
+func TestStatsStage(t *testing.T) {
+	test := func(query string, docs []string, args []seq.AggregateArgs, expected []seq.AggregationResult) {
+		t.Helper()
+		p := newDocsPipeline(query)
+		defer p.release()
+		require.NotNil(t, p.stats)
+		for _, doc := range docs {
+			_, ok := p.apply(StreamingDoc{Data: []byte(doc)})
+			require.False(t, ok)
+		}
+		qpr := seq.QPR{Aggs: p.stats.aggs}
+		require.Equal(t, expected, qpr.Aggregate(args))
+	}
+
+	docs := []string{
+		`{"message":"user=bob took 15ms","service":"api"}`,
+		`{"message":"user=alice took 700ms","service":"api"}`,
+		`{"message":"user=bob took 5ms","service":"db"}`,
+		`{"message":"user=eve took 1.5s","service":"db"}`,
+		`{"message":"health check"}`,
+	}
+
+	test(
+		`* | extract "user=<user> took <ms>ms" | stats count() by user`,
+		docs,
+		[]seq.AggregateArgs{{Func: seq.AggFuncCount}},
+		[]seq.AggregationResult{{
+			Buckets: []seq.AggregationBucket{
+				{Name: "_not_exists", Value: 2},
+				{Name: "bob", Value: 2},
+				{Name: "alice", Value: 1},
+			},
+			NotExists: 2,
+		}},
+	)
+	// Quantile of the values is estimated by the sketch within the relative error.
+	sketch := seq.NewQuantileSketch(seq.DefaultQuantileError)
+	sketch.Add(700, 1)
+	p50 := sketch.Quantile(0.5)
+	require.InEpsilon(t, 700, p50, seq.DefaultQuantileError)
+	test(
+		`* | extract "took <ms>ms" | stats count(), max(ms), p50(ms) by service`,
+		docs,
+		[]seq.AggregateArgs{{Func: seq.AggFuncCount}, {Func: seq.AggFuncMax}, {Func: seq.AggFuncQuantile, Quantiles: []float64{0.5}}},
+		[]seq.AggregationResult{
+			{
+				Buckets: []seq.AggregationBucket{
+					{Name: "api", Value: 2},
+					{Name: "db", Value: 2},
+					{Name: "_not_exists", Value: 1},
+				},
+				NotExists: 1,
+			},
+			{
+				Buckets: []seq.AggregationBucket{
+					{Name: "api", Value: 700},
+					{Name: "db", Value: 5, NotExists: 1},
+				},
+			},
+			{
+				Buckets: []seq.AggregationBucket{
+					{Name: "api", Value: p50, Quantiles: []float64{p50}},
+					{Name: "db", Value: 5, Quantiles: []float64{5}, NotExists: 1},
+				},
+			},
+		},
+	)
+	test(
+		`* | where service = "db" | stats count(), sum(latency)`,
+		[]string{`{"service":"db","latency":1}`, `{"service":"db","latency":"2.5"}`, `{"service":"api","latency":10}`, `{"service":"db"}`},
+		[]seq.AggregateArgs{{Func: seq.AggFuncCount}, {Func: seq.AggFuncSum}},
+		[]seq.AggregationResult{
+			{Buckets: []seq.AggregationBucket{{Name: "", Value: 3}}},
+			{Buckets: []seq.AggregationBucket{{Name: "", Value: 3.5, NotExists: 1}}},
+		},
+	)
+
+	// Documents without any of 'by' fields are not grouped.
+	test(
+		`* | extract "user=<user> took <ms>ms" | stats count(), max(ms) by service, user`,
+		docs,
+		[]seq.AggregateArgs{{Func: seq.AggFuncCount, GroupByFields: 2}, {Func: seq.AggFuncMax, GroupByFields: 2}},
+		[]seq.AggregationResult{
+			{
+				Buckets: []seq.AggregationBucket{
+					{Name: "api, alice", Value: 1, Keys: []string{"api", "alice"}},
+					{Name: "api, bob", Value: 1, Keys: []string{"api", "bob"}},
+					{Name: "db, bob", Value: 1, Keys: []string{"db", "bob"}},
+				},
+				NotExists: 2,
+			},
+			{
+				Buckets: []seq.AggregationBucket{
+					{Name: "api, alice", Value: 700, Keys: []string{"api", "alice"}},
+					{Name: "api, bob", Value: 15, Keys: []string{"api", "bob"}},
+					{Name: "db, bob", Value: 5, Keys: []string{"db", "bob"}},
+				},
+			},
+		},
+	)
+
+	// 'top' pipe counts the values, they are ranked after the aggregation.
+	test(
+		`* | extract "user=<user> " | top 1 user`,
+		docs,
+		[]seq.AggregateArgs{{Func: seq.AggFuncCount}},
+		[]seq.AggregationResult{{
+			Buckets: []seq.AggregationBucket{
+				{Name: "bob", Value: 2},
+				{Name: "_not_exists", Value: 1},
+				{Name: "alice", Value: 1},
+				{Name: "eve", Value: 1},
+			},
+			NotExists: 1,
+		}},
+	)
+
+	// 'timechart' pipe bins the documents by their time.
+	p := newDocsPipeline(`* | where service = "api" | timechart span=1s count() by service`)
+	defer p.release()
+	for _, mid := range []seq.MID{1500, 1999, 2000, 2700, 5001} {
+		p.apply(StreamingDoc{ID: seq.ID{MID: mid}, Data: []byte(`{"service":"api"}`)})
+	}
+	p.apply(StreamingDoc{ID: seq.ID{MID: 1000}, Data: []byte(`{"service":"db"}`)})
+	qpr := seq.QPR{Aggs: p.stats.aggs}
+	require.Equal(t, []seq.AggregationResult{{
+		Buckets: []seq.AggregationBucket{
+			{Name: "api", Value: 2, MID: 1000},
+			{Name: "api", Value: 2, MID: 2000},
+			{Name: "api", Value: 1, MID: 5000},
+		},
+	}}, qpr.Aggregate([]seq.AggregateArgs{{Func: seq.AggFuncCount, SkipWithoutTimestamp: true}}))
+
+	// 'stats' as the first pipe is calculated by stores.
+	require.Nil(t, newDocsPipeline(`* | stats count()`).stats)
+	require.Nil(t, newDocsPipeline(`* | rare service`).stats)
+	require.Nil(t, newDocsPipeline(`* | timechart span=1m count()`).stats)
+}
+
+type testFetchStream struct {
+	grpc.ClientStream
+	docs [][]byte
+}
+
+func (s *testFetchStream) Recv() (*storeapi.BinaryData, error) {
+	if len(s.docs) == 0 {
+		return nil, io.EOF
+	}
+	doc := s.docs[0]
+	s.docs = s.docs[1:]
+	return &storeapi.BinaryData{Data: doc}, nil
+}
+
+func TestSearchWhere(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+	const total = 250
+	resp := &storeapi.SearchResponse{Total: total}
+	docs := make(map[string][]byte, total)
+	for i := range total {
+		id := seq.NewID(now.Add(-time.Duration(i)*time.Second), 0)
+		resp.IdSources = append(resp.IdSources, &storeapi.SearchResponse_IdWithHint{
+			Id: &storeapi.SearchResponse_Id{Mid: uint64(id.MID), Rid: uint64(id.RID)},
+		})
+		level := "info"
+		if i%20 == 0 {
+			level = "error"
+		}
+		block := storage.PackDocBlock([]byte(`{"level":"`+level+`"}`), nil)
+		block.SetExt1(uint64(id.MID))
+		block.SetExt2(uint64(id.RID))
+		docs[id.String()] = block
+	}
+
+	store := mock.NewMockStoreApiClient(ctrl)
+	store.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.SearchRequest, _ ...grpc.CallOption) (*storeapi.SearchResponse, error) {
+			require.Equal(t, int64(0), req.Offset)
+			require.Equal(t, int64(1000), req.Size)
+			return resp, nil
+		}).Times(1)
+
+	fetched := 0
+	store.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.FetchRequest, _ ...grpc.CallOption) (storeapi.StoreApi_FetchClient, error) {
+			s := &testFetchStream{}
+			for _, id := range req.Ids {
+				s.docs = append(s.docs, docs[id])
+			}
+			fetched += len(req.Ids)
+			return s, nil
+		}).Times(2)
+
+	searchIngestor := NewIngestor(
+		Config{
+			HotStores:      &stores.Stores{Shards: [][]string{{"store1"}}},
+			MaxScannedDocs: 1000,
+		},
+		map[string]storeapi.StoreApiClient{"store1": store},
+	)
+
+	qpr, docsStream, _, err := searchIngestor.Search(ctx, &SearchRequest{
+		Q:           []byte(`* | where level = "error"`),
+		Offset:      2,
+		Size:        5,
+		ShouldFetch: true,
+		Order:       seq.DocsOrderDesc,
+	}, querytracer.New(false, "test"))
+	require.NoError(t, err)
+
+	// Every 20th document is an error, so 7 of them are found in the first two batches of 100 ids.
+	require.Equal(t, 200, fetched)
+	require.Equal(t, uint64(total), qpr.Total)
+	require.Len(t, qpr.IDs, 5)
+	for i, id := range qpr.IDs {
+		expected := seq.NewID(now.Add(-time.Duration((i+2)*20)*time.Second), 0)
+		require.Equal(t, expected, id.ID)
+	}
+	found := ReadAll(docsStream)
+	require.Len(t, found, 5)
+	for _, doc := range found {
+		require.Equal(t, `{"level":"error"}`, string(doc))
+	}
+}
+
+func TestSearchTail(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+	const total = 10
+	resp := &storeapi.SearchResponse{Total: total}
+	docs := make(map[string][]byte, total)
+	var ids []seq.ID
+	for i := range total {
+		id := seq.NewID(now.Add(-time.Duration(i)*time.Second), 0)
+		ids = append(ids, id)
+		block := storage.PackDocBlock([]byte(`{"n":`+strconv.Itoa(i)+`}`), nil)
+		block.SetExt1(uint64(id.MID))
+		block.SetExt2(uint64(id.RID))
+		docs[id.String()] = block
+	}
+
+	store := mock.NewMockStoreApiClient(ctrl)
+	store.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.SearchRequest, _ ...grpc.CallOption) (*storeapi.SearchResponse, error) {
+			// The last documents are searched in the reverse order.
+			require.Equal(t, storeapi.Order_ORDER_ASC, req.Order)
+			require.Equal(t, int64(3), req.Size)
+			resp := &storeapi.SearchResponse{Total: resp.Total}
+			for _, id := range slices.Backward(ids[total-3:]) {
+				resp.IdSources = append(resp.IdSources, &storeapi.SearchResponse_IdWithHint{
+					Id: &storeapi.SearchResponse_Id{Mid: uint64(id.MID), Rid: uint64(id.RID)},
+				})
+			}
+			return resp, nil
+		}).Times(1)
+
+	store.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.FetchRequest, _ ...grpc.CallOption) (storeapi.StoreApi_FetchClient, error) {
+			s := &testFetchStream{}
+			for _, id := range req.Ids {
+				s.docs = append(s.docs, docs[id])
+			}
+			return s, nil
+		}).Times(1)
+
+	searchIngestor := NewIngestor(
+		Config{HotStores: &stores.Stores{Shards: [][]string{{"store1"}}}},
+		map[string]storeapi.StoreApiClient{"store1": store},
+	)
+
+	qpr, docsStream, _, err := searchIngestor.Search(ctx, &SearchRequest{
+		Q:           []byte(`* | tail 3 | head 2`),
+		Size:        10,
+		ShouldFetch: true,
+		Order:       seq.DocsOrderDesc,
+	}, querytracer.New(false, "test"))
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(total), qpr.Total)
+	require.Len(t, qpr.IDs, 2)
+	require.Equal(t, ids[7], qpr.IDs[0].ID)
+	require.Equal(t, ids[8], qpr.IDs[1].ID)
+	found := ReadAll(docsStream)
+	require.Equal(t, [][]byte{[]byte(`{"n":7}`), []byte(`{"n":8}`)}, found)
+}
+
+func TestSearchStatsScanBudget(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	now := time.Now()
+	const total, budget = 30, 20
+	ids := make([]seq.ID, 0, total)
+	docs := make(map[string][]byte, total)
+	for i := range total {
+		id := seq.NewID(now.Add(-time.Duration(i)*time.Second), 0)
+		ids = append(ids, id)
+		block := storage.PackDocBlock([]byte(`{"level":"error"}`), nil)
+		block.SetExt1(uint64(id.MID))
+		block.SetExt2(uint64(id.RID))
+		docs[id.String()] = block
+	}
+
+	store := mock.NewMockStoreApiClient(ctrl)
+	store.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.SearchRequest, _ ...grpc.CallOption) (*storeapi.SearchResponse, error) {
+			// One more id than the budget is searched to detect that the found documents exceed it.
+			require.Equal(t, int64(budget+1), req.Size)
+			resp := &storeapi.SearchResponse{Total: total}
+			for _, id := range ids[:req.Size] {
+				resp.IdSources = append(resp.IdSources, &storeapi.SearchResponse_IdWithHint{
+					Id: &storeapi.SearchResponse_Id{Mid: uint64(id.MID), Rid: uint64(id.RID)},
+				})
+			}
+			return resp, nil
+		}).Times(1)
+
+	fetched := 0
+	store.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *storeapi.FetchRequest, _ ...grpc.CallOption) (storeapi.StoreApi_FetchClient, error) {
+			s := &testFetchStream{}
+			for _, id := range req.Ids {
+				s.docs = append(s.docs, docs[id])
+			}
+			fetched += len(req.Ids)
+			return s, nil
+		}).Times(1)
+
+	searchIngestor := NewIngestor(
+		Config{
+			HotStores:      &stores.Stores{Shards: [][]string{{"store1"}}},
+			MaxScannedDocs: budget,
+		},
+		map[string]storeapi.StoreApiClient{"store1": store},
+	)
+
+	qpr, _, _, err := searchIngestor.Search(ctx, &SearchRequest{
+		Q:     []byte(`* | where level = "error" | stats count()`),
+		Order: seq.DocsOrderDesc,
+	}, querytracer.New(false, "test"))
+	// Counts are calculated on the documents within the budget, so the response is marked as partial.
+	require.ErrorIs(t, err, consts.ErrPartialResponse)
+	require.Equal(t, budget, fetched)
+	res := qpr.Aggregate([]seq.AggregateArgs{{Func: seq.AggFuncCount}})
+	require.Len(t, res, 1)
+	require.Len(t, res[0].Buckets, 1)
+	require.Equal(t, float64(budget), res[0].Buckets[0].Value)
+}
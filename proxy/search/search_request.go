@@ -7,11 +7,27 @@ import (
 )
 
 type AggQuery struct {
-	Field     string
-	GroupBy   string
+	Field   string
+	GroupBy string
+	// ThenBy are the fields grouped by together with GroupBy.
+	ThenBy    []string
 	Func      seq.AggFunc
 	Quantiles []float64
-	Interval  seq.MID
+	// QuantileError is the relative error of the quantiles, the default one is used if it is zero.
+	QuantileError float64
+	Interval      seq.MID
+	// IntervalField is a date field used for time series instead of document timestamp.
+	IntervalField string
+	// Buckets split numeric values of GroupBy into ranges or histogram bins.
+	Buckets seq.NumericBuckets
+	// Filters are the named seq-ql queries counted instead of the groups.
+	Filters []AggFilter
+}
+
+// AggFilter is a named seq-ql query of the filters aggregation.
+type AggFilter struct {
+	Name  string
+	Query string
 }
 
 //nolint:revive // TODO: ***REMOVED***
@@ -27,6 +43,8 @@ type SearchRequest struct {
 	WithTotal   bool
 	ShouldFetch bool
 	Order       seq.DocsOrder
+	// Sort orders documents by values of the indexed fields instead of time.
+	Sort []seq.SortField
 }
 
 func (sr *SearchRequest) GetAPISearchRequest() *storeapi.SearchRequest {
@@ -41,9 +59,21 @@ func (sr *SearchRequest) GetAPISearchRequest() *storeapi.SearchRequest {
 		Explain:   sr.Explain,
 		WithTotal: sr.WithTotal,
 		Order:     storeapi.MustProtoOrder(sr.Order),
+		Sort:      convertToSortFields(sr.Sort),
 	}
 }
 
+func convertToSortFields(sort []seq.SortField) []*storeapi.SearchRequest_SortField {
+	if len(sort) == 0 {
+		return nil
+	}
+	fields := make([]*storeapi.SearchRequest_SortField, len(sort))
+	for i, s := range sort {
+		fields[i] = &storeapi.SearchRequest_SortField{Field: s.Field, Desc: s.Desc}
+	}
+	return fields
+}
+
 func convertToAggsQuery(aggs []AggQuery) []*storeapi.AggQuery {
 	buf := make([]storeapi.AggQuery, len(aggs))
 	aggQ := make([]*storeapi.AggQuery, len(aggs))
@@ -60,10 +90,19 @@ func convertToAggsQuery(aggs []AggQuery) []*storeapi.AggQuery {
 
 		buf[i].Field = field
 		buf[i].GroupBy = groupBy
+		buf[i].ThenBy = query.ThenBy
 
 		buf[i].Func = storeapi.AggFunc(query.Func)
 		buf[i].Quantiles = query.Quantiles
+		buf[i].QuantileError = query.QuantileError
 		buf[i].Interval = int64(query.Interval)
+		buf[i].IntervalField = query.IntervalField
+		buf[i].Ranges = query.Buckets.Ranges
+		buf[i].BinWidth = query.Buckets.BinWidth
+		buf[i].BinLogBase = query.Buckets.BinLogBase
+		for _, f := range query.Filters {
+			buf[i].Filters = append(buf[i].Filters, &storeapi.AggQuery_Filter{Name: f.Name, Query: f.Query})
+		}
 
 		aggQ[i] = &buf[i]
 	}
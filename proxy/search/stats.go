@@ -0,0 +1,106 @@
+package search
+
+import (
+	"strings"
+
+	insaneJSON "github.com/ozontech/insane-json"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// notExistsToken is the bucket of the documents without the group field, it is the same as stores return for count.
+const notExistsToken = "_not_exists"
+
+// statsStage calculates 'stats' pipe on the fetched documents like stores calculate aggregations,
+// so the results are merged with the aggregations of the request and returned the same way.
+type statsStage struct {
+	stats *parser.PipeStats
+	aggs  []seq.AggregatableSamples
+	// interval is the time bin of 'timechart' pipe, documents are not binned by time if it is zero.
+	interval seq.MID
+	// mid is the time of the processed document, it is set by the pipeline.
+	mid seq.MID
+}
+
+func newStatsStage(stats *parser.PipeStats) *statsStage {
+	s := &statsStage{
+		stats: stats,
+		aggs:  make([]seq.AggregatableSamples, len(stats.Funcs)),
+	}
+	for i := range s.aggs {
+		s.aggs[i].SamplesByBin = make(map[seq.AggBin]*seq.SamplesContainer)
+	}
+	return s
+}
+
+// process adds the document to the aggregations, it is consumed and never returned.
+func (s *statsStage) process(doc *insaneJSON.Root) bool {
+	group, hasGroup := s.group(doc)
+
+	for i, f := range s.stats.Funcs {
+		agg := &s.aggs[i]
+		if f.Func == seq.AggFuncCount {
+			if !hasGroup {
+				agg.NotExists++
+				if len(s.stats.By) == 1 {
+					// Stores don't return the bucket for grouping by multiple fields.
+					s.samples(agg, notExistsToken).Total++
+				}
+				continue
+			}
+			s.samples(agg, group).Total++
+			continue
+		}
+
+		n, hasValue := nodeValue(digField(doc.Node, f.Field)).number()
+		switch {
+		case !hasValue && !hasGroup:
+		case !hasValue:
+			s.samples(agg, group).NotExists++
+		case !hasGroup:
+			agg.NotExists++
+		default:
+			samples := s.samples(agg, group)
+			samples.InsertNTimes(n, 1)
+			if f.Func == seq.AggFuncQuantile {
+				samples.InsertSampleNTimes(n, 1, seq.DefaultQuantileError)
+			}
+		}
+	}
+	return false
+}
+
+// group returns the token of the values of 'by' fields joined like stores join them.
+// The document has no group if any of the fields is missing.
+func (s *statsStage) group(doc *insaneJSON.Root) (string, bool) {
+	if len(s.stats.By) == 0 {
+		return "", true
+	}
+	tokens := make([]string, len(s.stats.By))
+	for i, field := range s.stats.By {
+		v := nodeValue(digField(doc.Node, field))
+		if v.kind == valueMissing || v.kind == valueNull {
+			return "", false
+		}
+		tokens[i] = v.String()
+	}
+	return seq.JoinGroupTokens(tokens), true
+}
+
+func (s *statsStage) samples(agg *seq.AggregatableSamples, token string) *seq.SamplesContainer {
+	bin := seq.AggBin{MID: consts.DummyMID, Token: token}
+	if s.interval > 0 {
+		// Bins are aligned the same way as stores align them for time series.
+		bin.MID = s.mid - s.mid%s.interval
+	}
+	samples, ok := agg.SamplesByBin[bin]
+	if !ok {
+		samples = seq.NewSamplesContainers()
+		// Strings of the document point to the decoding buffer, which is reused for the next document.
+		bin.Token = strings.Clone(token)
+		agg.SamplesByBin[bin] = samples
+	}
+	return samples
+}
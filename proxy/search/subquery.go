@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/querytracer"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// resolveSubqueries runs subqueries of seq-ql query like `trace_id:in(select trace_id where level:error)`
+// within the same time range and substitutes the selected values into 'in' filters of the query.
+// Values are selected with 'unique' aggregation, the number of them is bounded by the limit of the subquery.
+// The query is returned as is if it has no subqueries or can't be parsed, stores report the error then.
+func (si *Ingestor) resolveSubqueries(
+	ctx context.Context,
+	query string,
+	from, to seq.MID,
+	explain bool,
+	tr *querytracer.Tracer,
+) (string, error) {
+	q, err := parser.ParseSeqQL(query, nil)
+	if err != nil || len(q.Subqueries) == 0 {
+		return query, nil
+	}
+
+	values := make([][]string, len(q.Subqueries))
+	for i, s := range q.Subqueries {
+		subTr := tr.NewChild("proxy/subquery")
+		// Subqueries of the inner query are resolved by the search itself.
+		qpr, _, _, err := si.Search(ctx, &SearchRequest{
+			Explain: explain,
+			Q:       []byte(s.Query),
+			From:    from,
+			To:      to,
+			AggQ:    []AggQuery{{GroupBy: s.Select, Func: seq.AggFuncUnique}},
+		}, subTr)
+		subTr.Done()
+		if errors.Is(err, consts.ErrPartialResponse) {
+			// Values of the partial response are incomplete, so the result of the query would be wrong.
+			return "", fmt.Errorf("subquery %q: %s", s.Query, err)
+		}
+		if err != nil {
+			return "", fmt.Errorf("subquery %q: %w", s.Query, err)
+		}
+		if len(qpr.Errors) > 0 {
+			return "", fmt.Errorf("subquery %q: %s", s.Query, qpr.CombineErrors())
+		}
+
+		agg := qpr.Aggregate([]seq.AggregateArgs{{Func: seq.AggFuncUnique}})[0]
+		for _, b := range agg.Buckets {
+			if len(values[i]) == s.Limit {
+				break
+			}
+			values[i] = append(values[i], b.Name)
+		}
+	}
+
+	return parser.ReplaceSubqueries(query, q.Subqueries, values), nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -19,6 +21,14 @@ func (g *grpcV1) StartAsyncSearch(
 	ctx context.Context,
 	r *seqproxyapi.StartAsyncSearchRequest,
 ) (*seqproxyapi.StartAsyncSearchResponse, error) {
+	for _, agg := range r.Aggs {
+		if len(agg.SubAggs) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "'subAggs' are not supported by async search")
+		}
+		if len(agg.Filters) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "'filters' are not supported by async search")
+		}
+	}
 	aggs, err := convertAggsQuery(r.Aggs)
 	if err != nil {
 		return nil, err
@@ -156,10 +166,16 @@ func makeProtoRequestAggregations(sourceAggs []search.AggQuery) []*seqproxyapi.A
 	aggs := make([]*seqproxyapi.AggQuery, 0, len(sourceAggs))
 	for _, agg := range sourceAggs {
 		aggs = append(aggs, &seqproxyapi.AggQuery{
-			Field:     agg.Field,
-			GroupBy:   agg.GroupBy,
-			Func:      seqproxyapi.AggFunc(agg.Func),
-			Quantiles: agg.Quantiles,
+			Field:         agg.Field,
+			GroupBy:       agg.GroupBy,
+			ThenBy:        agg.ThenBy,
+			Func:          seqproxyapi.AggFunc(agg.Func),
+			Quantiles:     agg.Quantiles,
+			QuantileError: agg.QuantileError,
+			IntervalField: agg.IntervalField,
+			Ranges:        agg.Buckets.Ranges,
+			BinWidth:      agg.Buckets.BinWidth,
+			BinLogBase:    agg.Buckets.BinLogBase,
 		})
 	}
 	return aggs
@@ -17,12 +17,13 @@ func (g *grpcV1) ComplexSearch(
 	ctx, cancel := context.WithTimeout(ctx, g.config.SearchTimeout)
 	defer cancel()
 
-	if req.Size <= 0 && req.Hist == nil && len(req.Aggs) == 0 {
+	stats := parseStatsPipe(req.Query.GetQuery())
+	if req.Size <= 0 && req.Hist == nil && len(req.Aggs) == 0 && stats == nil {
 		return nil, status.Error(codes.InvalidArgument, `one of "size", "hist" or "aggs" must be provided`)
 	}
 
 	tr := querytracer.New(req.Query.Explain, "proxy/ComplexSearch")
-	sResp, err := g.doSearch(ctx, req, true, tr)
+	sResp, err := g.doSearch(ctx, req, stats, true, tr)
 	if err != nil {
 		return nil, err
 	}
@@ -38,10 +39,9 @@ func (g *grpcV1) ComplexSearch(
 			Code: seqproxyapi.ErrorCode_ERROR_CODE_NO,
 		},
 	}
-	if req.Aggs != nil {
+	if len(sResp.aggs) > 0 {
 		aggTr := tr.NewChild("aggregate")
-		allAggregations := sResp.qpr.Aggregate(aggregationArgsFromProto(req.Aggs))
-		resp.Aggs = makeProtoAggregation(allAggregations)
+		resp.Aggs = sResp.aggregations()
 		aggTr.Done()
 	}
 	if req.Hist != nil {
@@ -67,6 +67,9 @@ func aggregationArgsFromProto(aggs []*seqproxyapi.AggQuery) []seq.AggregateArgs
 			Func:                 agg.Func.MustAggFunc(),
 			Quantiles:            agg.Quantiles,
 			SkipWithoutTimestamp: agg.Interval != nil,
+			GroupByFields:        1 + len(agg.ThenBy),
+			Buckets:              aggBuckets(agg),
+			Filters:              aggFilterNames(agg),
 		}
 	}
 	return args
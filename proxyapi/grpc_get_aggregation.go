@@ -15,7 +15,8 @@ func (g *grpcV1) GetAggregation(
 	ctx, cancel := context.WithTimeout(ctx, g.config.SearchTimeout)
 	defer cancel()
 
-	if req.Aggs == nil {
+	stats := parseStatsPipe(req.Query.GetQuery())
+	if req.Aggs == nil && stats == nil {
 		return nil, status.Error(codes.InvalidArgument, "agg query must be provided")
 	}
 
@@ -24,7 +25,7 @@ func (g *grpcV1) GetAggregation(
 		Aggs:  req.Aggs,
 	}
 
-	sResp, err := g.doSearch(ctx, proxyReq, false, nil)
+	sResp, err := g.doSearch(ctx, proxyReq, stats, false, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -33,10 +34,8 @@ func (g *grpcV1) GetAggregation(
 		return &seqproxyapi.GetAggregationResponse{Error: sResp.err}, nil
 	}
 
-	allAggregations := sResp.qpr.Aggregate(aggregationArgsFromProto(req.Aggs))
-
 	resp := &seqproxyapi.GetAggregationResponse{
-		Aggs:  makeProtoAggregation(allAggregations),
+		Aggs:  sResp.aggregations(),
 		Total: int64(sResp.qpr.Total),
 		Error: &seqproxyapi.Error{
 			Code: seqproxyapi.ErrorCode_ERROR_CODE_NO,
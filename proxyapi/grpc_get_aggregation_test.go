@@ -8,6 +8,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ozontech/seq-db/consts"
@@ -394,3 +396,30 @@ func TestGrpcV1_GetAggregation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAggFilters(t *testing.T) {
+	count := seqproxyapi.AggFunc_AGG_FUNC_COUNT
+	errorsFilter := &seqproxyapi.AggFilter{Name: "errors", Query: "level:error"}
+	timeoutsFilter := &seqproxyapi.AggFilter{Name: "timeouts", Query: "message:timeout"}
+
+	require.NoError(t, validateAgg(&seqproxyapi.AggQuery{Func: count, Filters: []*seqproxyapi.AggFilter{errorsFilter, timeoutsFilter}}))
+
+	test := func(agg *seqproxyapi.AggQuery, expected string) {
+		t.Helper()
+		err := validateAgg(agg)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), expected)
+	}
+	test(&seqproxyapi.AggQuery{Func: seqproxyapi.AggFunc_AGG_FUNC_AVG, Field: "duration", Filters: []*seqproxyapi.AggFilter{errorsFilter}},
+		"'filters' support only 'AGG_FUNC_COUNT'")
+	test(&seqproxyapi.AggQuery{Func: count, GroupBy: "service", Filters: []*seqproxyapi.AggFilter{errorsFilter}},
+		"'filters' can't be used with 'field', 'groupBy', 'thenBy' and buckets")
+	test(&seqproxyapi.AggQuery{Func: count, Filters: []*seqproxyapi.AggFilter{errorsFilter, errorsFilter}},
+		`filter name "errors" is repeated`)
+	test(&seqproxyapi.AggQuery{Func: count, Filters: []*seqproxyapi.AggFilter{{Query: "level:error"}}},
+		"filter 'name' must be set")
+	test(&seqproxyapi.AggQuery{Func: count, Filters: []*seqproxyapi.AggFilter{{Name: "bad", Query: "level:("}}},
+		`can't parse filter "bad"`)
+	test(&seqproxyapi.AggQuery{Func: count, Filters: []*seqproxyapi.AggFilter{{Name: "sub", Query: "user:in(select user where level:error)"}}},
+		`filter "sub" can't contain pipes, subqueries and '_time' filters`)
+}
@@ -77,7 +77,7 @@ type rlMockData struct {
 }
 
 type acMockData struct {
-	mapping seq.Mapping
+	mapping *seq.Mapping
 }
 
 type mocksData struct {
@@ -11,7 +11,7 @@ import (
 )
 
 type mappingTestCaseData struct {
-	mapping seq.Mapping
+	mapping *seq.Mapping
 	noResp  bool
 }
 
@@ -46,7 +46,7 @@ func TestGrpcV1_Mapping(t *testing.T) {
 		{
 			name: "ok",
 			data: mappingTestCaseData{
-				mapping: seq.Mapping{"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0)},
+				mapping: &seq.Mapping{Fields: map[string]seq.MappingTypes{"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0)}},
 			},
 			wantErr: false,
 		},
@@ -15,7 +15,8 @@ func (g *grpcV1) Search(
 	ctx, cancel := context.WithTimeout(ctx, g.config.SearchTimeout)
 	defer cancel()
 
-	if req.Size <= 0 {
+	stats := parseStatsPipe(req.Query.GetQuery())
+	if req.Size <= 0 && stats == nil {
 		return nil, status.Error(codes.InvalidArgument, `"size" must be greater than 0`)
 	}
 
@@ -25,8 +26,9 @@ func (g *grpcV1) Search(
 		Offset:    req.Offset,
 		WithTotal: req.WithTotal,
 		Order:     req.Order,
+		Sort:      req.Sort,
 	}
-	sResp, err := g.doSearch(ctx, proxyReq, true, nil)
+	sResp, err := g.doSearch(ctx, proxyReq, stats, true, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +43,9 @@ func (g *grpcV1) Search(
 			Code: seqproxyapi.ErrorCode_ERROR_CODE_NO,
 		},
 	}
+	if len(sResp.aggs) > 0 {
+		resp.Aggs = sResp.aggregations()
+	}
 	if sResp.err != nil {
 		resp.Error = sResp.err
 		resp.PartialResponse = sResp.err.Code == seqproxyapi.ErrorCode_ERROR_CODE_PARTIAL_RESPONSE
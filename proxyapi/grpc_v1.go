@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/ozontech/seq-db/consts"
 	"github.com/ozontech/seq-db/logger"
 	"github.com/ozontech/seq-db/metric"
+	"github.com/ozontech/seq-db/parser"
 	"github.com/ozontech/seq-db/pkg/seqproxyapi/v1"
 	"github.com/ozontech/seq-db/proxy/search"
 	"github.com/ozontech/seq-db/querytracer"
@@ -139,6 +141,8 @@ func makeProtoAggregation(allAggregations []seq.AggregationResult) []*seqproxyap
 
 			bucket.NotExists = item.NotExists
 			bucket.Quantiles = item.Quantiles
+			bucket.Percent = item.Percent
+			bucket.Keys = item.Keys
 
 			if item.MID != consts.DummyMID {
 				bucket.Ts = timestamppb.New(item.MID.Time())
@@ -157,27 +161,46 @@ func makeProtoAggregation(allAggregations []seq.AggregationResult) []*seqproxyap
 }
 
 func getSearchQueryFromGRPCReqForRateLimiter(req *seqproxyapi.ComplexSearchRequest) string {
-	rlQuery := []string{req.Query.Query}
-	if req.Aggs != nil {
-		for _, q := range req.Aggs {
-			rlQuery = append(rlQuery, q.Field, q.GroupBy, q.Func.String())
-		}
-	}
+	rlQuery := appendAggsForRateLimiter([]string{req.Query.Query}, req.Aggs)
 	if req.Hist != nil {
 		rlQuery = append(rlQuery, req.Hist.Interval)
 	}
 	return strings.Join(rlQuery, ",")
 }
 
+func appendAggsForRateLimiter(rlQuery []string, aggs []*seqproxyapi.AggQuery) []string {
+	for _, q := range aggs {
+		rlQuery = append(rlQuery, q.Field, q.GroupBy)
+		rlQuery = append(rlQuery, q.ThenBy...)
+		rlQuery = append(rlQuery, q.Func.String())
+		for _, f := range q.Filters {
+			rlQuery = append(rlQuery, f.Query)
+		}
+		rlQuery = appendAggsForRateLimiter(rlQuery, q.SubAggs)
+	}
+	return rlQuery
+}
+
 type proxySearchResponse struct {
 	qpr        *seq.QPR
 	docsStream search.DocsIterator
-	err        *seqproxyapi.Error
+	// aggs are the aggregation queries of the request followed by the ones of 'stats' pipe.
+	aggs []*seqproxyapi.AggQuery
+	// subAggs are the flat sub-aggregations which follow the aggregations of the request.
+	subAggs *subAggs
+	stats   *statsPipe
+	err     *seqproxyapi.Error
+}
+
+// aggregations returns results of the aggregations of the request and the ones of the pipe.
+func (r *proxySearchResponse) aggregations() []*seqproxyapi.Aggregation {
+	return r.subAggs.nest(r.stats.makeProtoAggregation(r.qpr.Aggregate(aggregationArgsFromProto(r.aggs))))
 }
 
 func (g *grpcV1) doSearch(
 	ctx context.Context,
 	req *seqproxyapi.ComplexSearchRequest,
+	stats *statsPipe,
 	shouldFetch bool,
 	tr *querytracer.Tracer,
 ) (*proxySearchResponse, error) {
@@ -236,12 +259,35 @@ func (g *grpcV1) doSearch(
 		Order:       req.Order.MustDocsOrder(),
 	}
 
-	if len(req.Aggs) > 0 {
-		aggs, err := convertAggsQuery(req.Aggs)
+	if len(req.Sort) > 0 {
+		sort, err := convertSortFields(req.Sort)
 		if err != nil {
 			return nil, err
 		}
-		proxyReq.AggQ = aggs
+		proxyReq.Sort = sort
+	}
+
+	aggs, subAggs, err := flattenSubAggs(req.Aggs)
+	if err != nil {
+		return nil, err
+	}
+	storesAggs := aggs
+	if stats != nil {
+		// 'stats' pipe replaces the found documents with the aggregations.
+		aggs = append(slices.Clip(aggs), stats.aggs...)
+		if !stats.onProxy {
+			storesAggs = aggs
+		}
+		proxyReq.Size = 0
+		proxyReq.Offset = 0
+		proxyReq.ShouldFetch = false
+	}
+	if len(storesAggs) > 0 {
+		aggQ, err := convertAggsQuery(storesAggs)
+		if err != nil {
+			return nil, err
+		}
+		proxyReq.AggQ = aggQ
 	}
 
 	if req.Hist != nil {
@@ -260,6 +306,9 @@ func (g *grpcV1) doSearch(
 	psr := &proxySearchResponse{
 		qpr:        qpr,
 		docsStream: docsStream,
+		aggs:       aggs,
+		subAggs:    subAggs,
+		stats:      stats,
 	}
 
 	if e, ok := parseProxyError(err); ok {
@@ -296,6 +345,17 @@ func (g *grpcV1) doSearch(
 	return psr, nil
 }
 
+func convertSortFields(fields []*seqproxyapi.SortField) ([]seq.SortField, error) {
+	result := make([]seq.SortField, 0, len(fields))
+	for _, f := range fields {
+		if f.Field == "" {
+			return nil, status.Error(codes.InvalidArgument, "sort field must be provided")
+		}
+		result = append(result, seq.SortField{Field: f.Field, Desc: f.Desc})
+	}
+	return result, nil
+}
+
 func convertAggsQuery(aggs []*seqproxyapi.AggQuery) ([]search.AggQuery, error) {
 	var result []search.AggQuery
 	for _, agg := range aggs {
@@ -309,10 +369,17 @@ func convertAggsQuery(aggs []*seqproxyapi.AggQuery) ([]search.AggQuery, error) {
 		}
 
 		aggQuery := search.AggQuery{
-			Field:     agg.Field,
-			GroupBy:   agg.GroupBy,
-			Func:      aggFunc,
-			Quantiles: agg.Quantiles,
+			Field:         agg.Field,
+			GroupBy:       agg.GroupBy,
+			ThenBy:        agg.ThenBy,
+			Func:          aggFunc,
+			Quantiles:     agg.Quantiles,
+			QuantileError: agg.QuantileError,
+			IntervalField: agg.IntervalField,
+			Buckets:       aggBuckets(agg),
+		}
+		for _, f := range agg.Filters {
+			aggQuery.Filters = append(aggQuery.Filters, search.AggFilter{Name: f.Name, Query: f.Query})
 		}
 
 		if agg.Interval == nil {
@@ -335,6 +402,27 @@ func convertAggsQuery(aggs []*seqproxyapi.AggQuery) ([]search.AggQuery, error) {
 	return result, nil
 }
 
+// aggBuckets returns the buckets of the numeric group field of the aggregation.
+func aggBuckets(agg *seqproxyapi.AggQuery) seq.NumericBuckets {
+	return seq.NumericBuckets{
+		Ranges:     agg.Ranges,
+		BinWidth:   agg.BinWidth,
+		BinLogBase: agg.BinLogBase,
+	}
+}
+
+// aggFilterNames returns the names of the filters of the aggregation.
+func aggFilterNames(agg *seqproxyapi.AggQuery) []string {
+	if len(agg.Filters) == 0 {
+		return nil
+	}
+	names := make([]string, len(agg.Filters))
+	for i, f := range agg.Filters {
+		names[i] = f.Name
+	}
+	return names
+}
+
 func (g *grpcV1) tryMirrorRequest(req *seqproxyapi.ComplexSearchRequest) {
 	if g.mirror == nil {
 		return
@@ -358,6 +446,31 @@ func (g *grpcV1) tryMirrorRequest(req *seqproxyapi.ComplexSearchRequest) {
 }
 
 func validateAgg(agg *seqproxyapi.AggQuery) error {
+	if agg.IntervalField != "" && agg.Interval == nil {
+		return status.Error(codes.InvalidArgument, "'interval' must be set to use 'intervalField'")
+	}
+
+	if len(agg.Filters) > 0 {
+		// Filters are counted instead of the groups, so the checks of the functions are not applied.
+		return validateAggFilters(agg)
+	}
+
+	if len(agg.ThenBy) > 0 && agg.GroupBy == "" {
+		return status.Error(codes.InvalidArgument, "'groupBy' must be set to use 'thenBy'")
+	}
+	if 1+len(agg.ThenBy) > seq.MaxGroupByFields {
+		return status.Errorf(codes.InvalidArgument, "grouping by more than %d fields is not supported", seq.MaxGroupByFields)
+	}
+
+	if buckets := aggBuckets(agg); buckets.IsSet() {
+		if len(aggGroupFields(agg)) == 0 {
+			return status.Error(codes.InvalidArgument, "'groupBy' must be set to use 'ranges', 'binWidth' or 'binLogBase'")
+		}
+		if err := buckets.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid buckets: %s", err)
+		}
+	}
+
 	switch agg.Func {
 	case seqproxyapi.AggFunc_AGG_FUNC_COUNT:
 		// Expect COUNT to support legacy format in which 'field' means 'groupBy'
@@ -378,7 +491,8 @@ func validateAgg(agg *seqproxyapi.AggQuery) error {
 		}
 
 	case seqproxyapi.AggFunc_AGG_FUNC_SUM, seqproxyapi.AggFunc_AGG_FUNC_MIN,
-		seqproxyapi.AggFunc_AGG_FUNC_MAX, seqproxyapi.AggFunc_AGG_FUNC_AVG:
+		seqproxyapi.AggFunc_AGG_FUNC_MAX, seqproxyapi.AggFunc_AGG_FUNC_AVG,
+		seqproxyapi.AggFunc_AGG_FUNC_CARDINALITY:
 		if agg.Field == "" {
 			return status.Error(codes.InvalidArgument, "'field' must be set")
 		}
@@ -397,6 +511,43 @@ func validateAgg(agg *seqproxyapi.AggQuery) error {
 				return status.Error(codes.InvalidArgument, "quantile must be between 0 and 1")
 			}
 		}
+
+		if e := agg.QuantileError; e != 0 && (e < seq.MinQuantileError || e > seq.MaxQuantileError) {
+			return status.Errorf(codes.InvalidArgument, "'quantileError' must be between %v and %v",
+				seq.MinQuantileError, seq.MaxQuantileError)
+		}
+	}
+	return nil
+}
+
+func validateAggFilters(agg *seqproxyapi.AggQuery) error {
+	if agg.Func != seqproxyapi.AggFunc_AGG_FUNC_COUNT {
+		return status.Error(codes.InvalidArgument, "'filters' support only 'AGG_FUNC_COUNT'")
+	}
+	if agg.Field != "" || agg.GroupBy != "" || len(agg.ThenBy) > 0 || aggBuckets(agg).IsSet() {
+		return status.Error(codes.InvalidArgument, "'filters' can't be used with 'field', 'groupBy', 'thenBy' and buckets")
+	}
+	if len(agg.Filters) > seq.MaxAggFilters {
+		return status.Errorf(codes.InvalidArgument, "more than %d 'filters' are not supported", seq.MaxAggFilters)
+	}
+
+	names := make(map[string]struct{}, len(agg.Filters))
+	for _, f := range agg.Filters {
+		if f.Name == "" {
+			return status.Error(codes.InvalidArgument, "filter 'name' must be set")
+		}
+		if _, ok := names[f.Name]; ok {
+			return status.Errorf(codes.InvalidArgument, "filter name %q is repeated", f.Name)
+		}
+		names[f.Name] = struct{}{}
+
+		q, err := parser.ParseSeqQL(f.Query, nil)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "can't parse filter %q: %s", f.Name, err)
+		}
+		if len(q.Pipes) > 0 || len(q.Subqueries) > 0 || q.Time != nil {
+			return status.Errorf(codes.InvalidArgument, "filter %q can't contain pipes, subqueries and '_time' filters", f.Name)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,194 @@
+package proxyapi
+
+import (
+	"cmp"
+	"maps"
+	"math"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-db/parser"
+	"github.com/ozontech/seq-db/pkg/seqproxyapi/v1"
+	"github.com/ozontech/seq-db/seq"
+)
+
+const (
+	// otherBucket sums up the values which are not returned by 'top' and 'rare' pipes.
+	otherBucket = "_other"
+	// notExistsBucket is the bucket of the documents without the group field.
+	notExistsBucket = "_not_exists"
+)
+
+// statsPipe is 'stats' pipe of the query compiled to aggregation queries, one per function.
+// Pipes 'top' and 'rare' are compiled the same way as `stats count() by field`,
+// and 'timechart' pipe is compiled to time series aggregations.
+type statsPipe struct {
+	aggs []*seqproxyapi.AggQuery
+	// onProxy is true if the pipe follows the other pipes, so it is calculated by seq-proxy
+	// on the fetched documents and the aggregations are not sent to stores.
+	onProxy bool
+	// top is set for 'top' and 'rare' pipes, the counts are ranked by it.
+	top *parser.PipeTop
+	// timechart is true for 'timechart' pipe, the aggregations are returned as time series.
+	timechart bool
+}
+
+// parseStatsPipe returns nil if there is no 'stats', 'top', 'rare' or 'timechart' pipe. Invalid query is ignored here, it is reported by search.
+func parseStatsPipe(query string) *statsPipe {
+	q, err := parser.ParseSeqQL(query, nil)
+	if err != nil {
+		return nil
+	}
+	for i, pipe := range q.Pipes {
+		var (
+			stats    *parser.PipeStats
+			top      *parser.PipeTop
+			interval *string
+		)
+		switch pipe := pipe.(type) {
+		case *parser.PipeStats:
+			stats = pipe
+		case *parser.PipeTop:
+			stats, top = pipe.Stats(), pipe
+		case *parser.PipeTimechart:
+			span := parser.FormatSpan(pipe.Span)
+			stats, interval = pipe.Stats(), &span
+		default:
+			continue
+		}
+
+		var (
+			groupBy string
+			thenBy  []string
+		)
+		if len(stats.By) > 0 {
+			groupBy = stats.By[0]
+		}
+		if len(stats.By) > 1 {
+			thenBy = stats.By[1:]
+		}
+		aggs := make([]*seqproxyapi.AggQuery, 0, len(stats.Funcs))
+		for _, f := range stats.Funcs {
+			agg := &seqproxyapi.AggQuery{
+				Field:    f.Field,
+				GroupBy:  groupBy,
+				ThenBy:   thenBy,
+				Func:     seqproxyapi.MustProtoAggFunc(f.Func),
+				Interval: interval,
+			}
+			if f.Func == seq.AggFuncCount && groupBy == "" {
+				// Every document has the single token of '_all_' field,
+				// so grouping by it counts all the documents in one bucket.
+				agg.GroupBy = seq.TokenAll
+			}
+			if f.Func == seq.AggFuncQuantile {
+				agg.Quantiles = []float64{f.Quantile}
+			}
+			aggs = append(aggs, agg)
+		}
+		return &statsPipe{aggs: aggs, onProxy: i > 0, top: top, timechart: interval != nil}
+	}
+	return nil
+}
+
+// rank keeps the most (or the least for 'rare') frequent values of the 'top' pipe count, which is the last aggregation,
+// the rest of the values are summed up in the bucket "_other". Documents without the field are not ranked,
+// they are returned as NotExists of the aggregation.
+func (p *statsPipe) rank(aggs []seq.AggregationResult) {
+	if p == nil || p.top == nil || len(aggs) == 0 {
+		return
+	}
+	agg := &aggs[len(aggs)-1]
+
+	buckets := slices.DeleteFunc(agg.Buckets, func(b seq.AggregationBucket) bool {
+		return b.Name == notExistsBucket
+	})
+	slices.SortFunc(buckets, func(left, right seq.AggregationBucket) int {
+		if p.top.Rare {
+			return cmp.Or(cmp.Compare(left.Value, right.Value), cmp.Compare(left.Name, right.Name))
+		}
+		return cmp.Or(cmp.Compare(right.Value, left.Value), cmp.Compare(left.Name, right.Name))
+	})
+
+	total := 0.0
+	for _, b := range buckets {
+		total += b.Value
+	}
+	if len(buckets) > p.top.Limit {
+		other := seq.AggregationBucket{Name: otherBucket, MID: buckets[0].MID}
+		for _, b := range buckets[p.top.Limit:] {
+			other.Value += b.Value
+		}
+		buckets = append(buckets[:p.top.Limit], other)
+	}
+	for i := range buckets {
+		buckets[i].Percent = buckets[i].Value / total * 100
+	}
+	agg.Buckets = buckets
+}
+
+// makeProtoAggregation converts the aggregations of the request followed by the ones of the pipe,
+// which are ranked for 'top' and 'rare' pipes and converted to time series for 'timechart' pipe.
+func (p *statsPipe) makeProtoAggregation(aggs []seq.AggregationResult) []*seqproxyapi.Aggregation {
+	if p == nil {
+		return makeProtoAggregation(aggs)
+	}
+	p.rank(aggs)
+	if !p.timechart {
+		return makeProtoAggregation(aggs)
+	}
+
+	n := len(aggs) - len(p.aggs)
+	res := makeProtoAggregation(aggs[:n])
+	for i, agg := range aggs[n:] {
+		// Count of the bin without documents is zero, while the other functions have no value.
+		missing := math.NaN()
+		if p.aggs[i].Func == seqproxyapi.AggFunc_AGG_FUNC_COUNT {
+			missing = 0
+		}
+		res = append(res, makeProtoSeries(agg, missing))
+	}
+	return res
+}
+
+// makeProtoSeries converts time series buckets to the columnar form: timestamps of all the bins
+// and the values of each group aligned with them, bins without the group are filled with missing value.
+func makeProtoSeries(agg seq.AggregationResult, missing float64) *seqproxyapi.Aggregation {
+	bins := make(map[seq.MID]int)
+	groups := make(map[string][]float64)
+	keys := make(map[string][]string)
+	for _, b := range agg.Buckets {
+		bins[b.MID] = 0
+		groups[b.Name] = nil
+		keys[b.Name] = b.Keys
+	}
+
+	mids := slices.Sorted(maps.Keys(bins))
+	timestamps := make([]*timestamppb.Timestamp, len(mids))
+	for i, mid := range mids {
+		bins[mid] = i
+		timestamps[i] = timestamppb.New(mid.Time())
+	}
+
+	for name := range groups {
+		values := make([]float64, len(mids))
+		for i := range values {
+			values[i] = missing
+		}
+		groups[name] = values
+	}
+	for _, b := range agg.Buckets {
+		groups[b.Name][bins[b.MID]] = b.Value
+	}
+
+	series := make([]*seqproxyapi.Aggregation_Series, 0, len(groups))
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		series = append(series, &seqproxyapi.Aggregation_Series{Key: name, Keys: keys[name], Values: groups[name]})
+	}
+	return &seqproxyapi.Aggregation{
+		NotExists:  agg.NotExists,
+		Timestamps: timestamps,
+		Series:     series,
+	}
+}
@@ -0,0 +1,171 @@
+package proxyapi
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozontech/seq-db/pkg/seqproxyapi/v1"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestParseStatsPipe(t *testing.T) {
+	require.Nil(t, parseStatsPipe(`service:api`))
+	require.Nil(t, parseStatsPipe(`service:api | fields level`))
+	require.Nil(t, parseStatsPipe(`service:(`))
+
+	statsAggQueries := func(query string) []*seqproxyapi.AggQuery {
+		stats := parseStatsPipe(query)
+		require.NotNil(t, stats)
+		require.False(t, stats.onProxy)
+		return stats.aggs
+	}
+
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: seq.TokenAll, Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT},
+	}, statsAggQueries(`* | stats count()`))
+
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "service", Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT},
+		{Field: "duration", GroupBy: "service", Func: seqproxyapi.AggFunc_AGG_FUNC_AVG},
+		{Field: "duration", GroupBy: "service", Func: seqproxyapi.AggFunc_AGG_FUNC_QUANTILE, Quantiles: []float64{0.99}},
+	}, statsAggQueries(`level:error | stats count(), avg(duration), p99(duration) by service`))
+
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "service", ThenBy: []string{"level"}, Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT},
+		{Field: "duration", GroupBy: "service", ThenBy: []string{"level"}, Func: seqproxyapi.AggFunc_AGG_FUNC_MAX},
+	}, statsAggQueries(`* | stats count(), max(duration) by service, level`))
+
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{Field: "duration", Func: seqproxyapi.AggFunc_AGG_FUNC_MAX},
+		{Field: "duration", Func: seqproxyapi.AggFunc_AGG_FUNC_SUM},
+	}, statsAggQueries(`* | stats max(duration), sum(duration)`))
+
+	// 'stats' pipe following the other pipes is calculated by seq-proxy.
+	stats := parseStatsPipe(`* | where level = "error" | stats count() by service`)
+	require.True(t, stats.onProxy)
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "service", Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT},
+	}, stats.aggs)
+}
+
+func TestStatsPipeRank(t *testing.T) {
+	stats := parseStatsPipe(`* | top 2 service`)
+	require.NotNil(t, stats)
+	require.False(t, stats.onProxy)
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "service", Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT},
+	}, stats.aggs)
+
+	counts := func() []seq.AggregationResult {
+		return []seq.AggregationResult{{
+			Buckets: []seq.AggregationBucket{
+				{Name: "api", Value: 10},
+				{Name: "_not_exists", Value: 5},
+				{Name: "db", Value: 6},
+				{Name: "auth", Value: 1},
+				{Name: "cache", Value: 3},
+			},
+			NotExists: 5,
+		}}
+	}
+
+	aggs := counts()
+	stats.rank(aggs)
+	require.Equal(t, []seq.AggregationResult{{
+		Buckets: []seq.AggregationBucket{
+			{Name: "api", Value: 10, Percent: 50},
+			{Name: "db", Value: 6, Percent: 30},
+			{Name: "_other", Value: 4, Percent: 20},
+		},
+		NotExists: 5,
+	}}, aggs)
+
+	aggs = counts()
+	parseStatsPipe(`* | where level = "error" | rare 3 service`).rank(aggs)
+	require.Equal(t, []seq.AggregationResult{{
+		Buckets: []seq.AggregationBucket{
+			{Name: "auth", Value: 1, Percent: 5},
+			{Name: "cache", Value: 3, Percent: 15},
+			{Name: "db", Value: 6, Percent: 30},
+			{Name: "_other", Value: 10, Percent: 50},
+		},
+		NotExists: 5,
+	}}, aggs)
+
+	// All the values fit, so there is no "_other" bucket.
+	aggs = counts()
+	parseStatsPipe(`* | top service`).rank(aggs)
+	require.Len(t, aggs[0].Buckets, 4)
+	require.Equal(t, "api", aggs[0].Buckets[0].Name)
+
+	// Aggregations of 'stats' pipe are not ranked.
+	aggs = counts()
+	parseStatsPipe(`* | stats count() by service`).rank(aggs)
+	require.Equal(t, counts(), aggs)
+}
+
+func TestStatsPipeTimechart(t *testing.T) {
+	stats := parseStatsPipe(`* | timechart span=60s count(), avg(duration) by level`)
+	require.NotNil(t, stats)
+	require.True(t, stats.timechart)
+	interval := "1m"
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "level", Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT, Interval: &interval},
+		{Field: "duration", GroupBy: "level", Func: seqproxyapi.AggFunc_AGG_FUNC_AVG, Interval: &interval},
+	}, stats.aggs)
+
+	minute := seq.MID(time.Minute.Milliseconds())
+	aggs := []seq.AggregationResult{
+		// Aggregation of the request is returned as is.
+		{Buckets: []seq.AggregationBucket{{Name: "api", Value: 1}}},
+		{
+			Buckets: []seq.AggregationBucket{
+				{Name: "error", Value: 3, MID: minute},
+				{Name: "info", Value: 1, MID: 0},
+				{Name: "info", Value: 2, MID: 3 * minute},
+			},
+			NotExists: 1,
+		},
+		{
+			Buckets: []seq.AggregationBucket{
+				{Name: "error", Value: 15, MID: minute},
+				{Name: "info", Value: 5, MID: 0},
+				{Name: "info", Value: 10, MID: 3 * minute},
+			},
+		},
+	}
+	res := stats.makeProtoAggregation(aggs)
+	require.Len(t, res, 3)
+	require.Equal(t, "api", res[0].Buckets[0].Key)
+
+	timestamps := []*timestamppb.Timestamp{
+		timestamppb.New(seq.MID(0).Time()),
+		timestamppb.New(minute.Time()),
+		timestamppb.New((3 * minute).Time()),
+	}
+	require.Empty(t, res[1].Buckets)
+	require.Equal(t, timestamps, res[1].Timestamps)
+	require.Equal(t, int64(1), res[1].NotExists)
+	require.Equal(t, []*seqproxyapi.Aggregation_Series{
+		{Key: "error", Values: []float64{0, 3, 0}},
+		{Key: "info", Values: []float64{1, 0, 2}},
+	}, res[1].Series)
+
+	// Bins without the group have no average.
+	require.Equal(t, timestamps, res[2].Timestamps)
+	require.Len(t, res[2].Series, 2)
+	values := map[string][]string{}
+	for _, s := range res[2].Series {
+		for _, v := range s.Values {
+			values[s.Key] = append(values[s.Key], strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	}
+	require.Equal(t, map[string][]string{
+		"error": {"NaN", "15", "NaN"},
+		"info":  {"5", "NaN", "10"},
+	}, values)
+}
@@ -0,0 +1,194 @@
+package proxyapi
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-db/pkg/seqproxyapi/v1"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// subAggs describes the sub-aggregations of the request which are flattened into the aggregations
+// grouped by the fields of their parents followed by their own ones, so stores calculate them in the same scan.
+// Results of the flat aggregations are nested into the buckets of the parents by the values of the parent fields.
+type subAggs struct {
+	// n is the number of the aggregations of the request, the flat sub-aggregations follow them.
+	n int
+	// parents[i] is the index of the parent of the sub-aggregation n+i,
+	// pos[i] is its index in the sub-aggregations of the parent.
+	parents []int
+	pos     []int
+	// parentFields[i] is the number of the group fields of the parent of the sub-aggregation n+i.
+	parentFields []int
+	// count[j] is the number of the sub-aggregations of the flat aggregation j.
+	count []int
+}
+
+// flattenSubAggs returns the aggregations of the request followed by their flat sub-aggregations.
+// Sub-aggregations are nil if the request has none of them.
+func flattenSubAggs(aggs []*seqproxyapi.AggQuery) ([]*seqproxyapi.AggQuery, *subAggs, error) {
+	hasSubAggs := false
+	for _, agg := range aggs {
+		hasSubAggs = hasSubAggs || len(agg.SubAggs) > 0
+	}
+	if !hasSubAggs {
+		return aggs, nil, nil
+	}
+
+	s := &subAggs{n: len(aggs)}
+	flat := make([]*seqproxyapi.AggQuery, len(aggs))
+	children := make([][]*seqproxyapi.AggQuery, len(aggs))
+	fields := make([][]string, len(aggs))
+	for i, agg := range aggs {
+		flat[i] = agg
+		if len(agg.SubAggs) > 0 {
+			flat[i] = &seqproxyapi.AggQuery{
+				Field:         agg.Field,
+				GroupBy:       agg.GroupBy,
+				ThenBy:        agg.ThenBy,
+				Func:          agg.Func,
+				Quantiles:     agg.Quantiles,
+				QuantileError: agg.QuantileError,
+				Interval:      agg.Interval,
+				IntervalField: agg.IntervalField,
+				Ranges:        agg.Ranges,
+				BinWidth:      agg.BinWidth,
+				BinLogBase:    agg.BinLogBase,
+			}
+		}
+		children[i] = agg.SubAggs
+		fields[i] = aggGroupFields(agg)
+	}
+
+	// Parents precede their sub-aggregations, so the flat list grows while it is iterated.
+	for parent := 0; parent < len(flat); parent++ {
+		s.count = append(s.count, len(children[parent]))
+		if len(children[parent]) == 0 {
+			continue
+		}
+		if len(fields[parent]) == 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "'subAggs' require 'groupBy' of the parent aggregation")
+		}
+		if flat[parent].Interval != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "'subAggs' do not support timeseries")
+		}
+
+		for pos, child := range children[parent] {
+			if child.Interval != nil {
+				return nil, nil, status.Error(codes.InvalidArgument, "'subAggs' do not support timeseries")
+			}
+			own := aggGroupFields(child)
+			if child.Func == seqproxyapi.AggFunc_AGG_FUNC_UNIQUE && len(own) == 0 {
+				return nil, nil, status.Error(codes.InvalidArgument, "'groupBy' must be set")
+			}
+			if len(child.Filters) > 0 {
+				return nil, nil, status.Error(codes.InvalidArgument, "'subAggs' do not support 'filters'")
+			}
+			if aggBuckets(child).IsSet() {
+				return nil, nil, status.Error(codes.InvalidArgument,
+					"'subAggs' do not support buckets, they inherit the ones of the parent aggregation")
+			}
+			childFields := append(append([]string(nil), fields[parent]...), own...)
+			if len(childFields) > seq.MaxGroupByFields {
+				return nil, nil, status.Errorf(codes.InvalidArgument,
+					"grouping by more than %d fields including the ones of the parent aggregations is not supported",
+					seq.MaxGroupByFields)
+			}
+
+			f := &seqproxyapi.AggQuery{
+				Field:         child.Field,
+				GroupBy:       childFields[0],
+				Func:          child.Func,
+				Quantiles:     child.Quantiles,
+				QuantileError: child.QuantileError,
+				// Values of the first group field are bucketed the same way as the ones of the parent.
+				Ranges:     flat[parent].Ranges,
+				BinWidth:   flat[parent].BinWidth,
+				BinLogBase: flat[parent].BinLogBase,
+			}
+			if len(childFields) > 1 {
+				f.ThenBy = childFields[1:]
+			}
+			if child.Func == seqproxyapi.AggFunc_AGG_FUNC_COUNT {
+				// Field of count is its group field in the legacy format.
+				f.Field = ""
+			}
+			flat = append(flat, f)
+			children = append(children, child.SubAggs)
+			fields = append(fields, childFields)
+			s.parents = append(s.parents, parent)
+			s.pos = append(s.pos, pos)
+			s.parentFields = append(s.parentFields, len(fields[parent]))
+		}
+	}
+	return flat, s, nil
+}
+
+// aggGroupFields returns the fields grouped by the aggregation.
+func aggGroupFields(agg *seqproxyapi.AggQuery) []string {
+	groupBy := agg.GroupBy
+	// Support legacy format in which field means groupBy.
+	if agg.Func == seqproxyapi.AggFunc_AGG_FUNC_COUNT && agg.Field != "" {
+		groupBy = agg.Field
+	}
+	if groupBy == "" {
+		return nil
+	}
+	return append([]string{groupBy}, agg.ThenBy...)
+}
+
+// nest moves the results of the flat sub-aggregations into the buckets of their parents,
+// the aggregations which follow them (e.g. the ones of 'stats' pipe) are kept.
+func (s *subAggs) nest(aggs []*seqproxyapi.Aggregation) []*seqproxyapi.Aggregation {
+	if s == nil {
+		return aggs
+	}
+
+	// Deeper sub-aggregations follow their parents, so they are nested first,
+	// while the keys of the parent buckets are not trimmed yet.
+	for i := len(s.parents) - 1; i >= 0; i-- {
+		parent, child := s.parents[i], aggs[s.n+i]
+		n := s.parentFields[i]
+
+		byParent := make(map[string][]*seqproxyapi.Aggregation_Bucket)
+		for _, b := range child.Buckets {
+			keys := bucketKeys(b)
+			if len(keys) < n {
+				continue
+			}
+			parentKey := seq.JoinGroupTokens(keys[:n])
+			byParent[parentKey] = append(byParent[parentKey], b)
+			setBucketKeys(b, keys[n:])
+		}
+
+		for _, b := range aggs[parent].Buckets {
+			if b.SubAggs == nil {
+				b.SubAggs = make([]*seqproxyapi.Aggregation, s.count[parent])
+			}
+			b.SubAggs[s.pos[i]] = &seqproxyapi.Aggregation{
+				Buckets: byParent[seq.JoinGroupTokens(bucketKeys(b))],
+			}
+		}
+	}
+
+	return append(aggs[:s.n], aggs[s.n+len(s.parents):]...)
+}
+
+// bucketKeys returns the values of the group fields of the bucket.
+func bucketKeys(b *seqproxyapi.Aggregation_Bucket) []string {
+	if len(b.Keys) > 0 {
+		return b.Keys
+	}
+	return []string{b.Key}
+}
+
+// setBucketKeys sets the values of the group fields the same way as they are set for the flat aggregation.
+func setBucketKeys(b *seqproxyapi.Aggregation_Bucket, keys []string) {
+	b.Key = strings.Join(keys, ", ")
+	b.Keys = nil
+	if len(keys) > 1 {
+		b.Keys = keys
+	}
+}
@@ -0,0 +1,175 @@
+package proxyapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozontech/seq-db/pkg/seqproxyapi/v1"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestFlattenSubAggs(t *testing.T) {
+	count := seqproxyapi.AggFunc_AGG_FUNC_COUNT
+	avg := seqproxyapi.AggFunc_AGG_FUNC_AVG
+
+	aggs := []*seqproxyapi.AggQuery{{GroupBy: "level", Func: count}}
+	flat, subAggs, err := flattenSubAggs(aggs)
+	require.NoError(t, err)
+	require.Nil(t, subAggs)
+	require.Equal(t, aggs, flat)
+
+	aggs = []*seqproxyapi.AggQuery{
+		{Field: "duration", Func: seqproxyapi.AggFunc_AGG_FUNC_MAX},
+		{
+			GroupBy: "service",
+			Func:    count,
+			SubAggs: []*seqproxyapi.AggQuery{
+				{Field: "duration", Func: avg},
+				{
+					GroupBy: "level",
+					Func:    count,
+					SubAggs: []*seqproxyapi.AggQuery{{Field: "duration", Func: avg}},
+				},
+			},
+		},
+	}
+	flat, subAggs, err = flattenSubAggs(aggs)
+	require.NoError(t, err)
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{Field: "duration", Func: seqproxyapi.AggFunc_AGG_FUNC_MAX},
+		{GroupBy: "service", Func: count},
+		{Field: "duration", GroupBy: "service", Func: avg},
+		{GroupBy: "service", ThenBy: []string{"level"}, Func: count},
+		{Field: "duration", GroupBy: "service", ThenBy: []string{"level"}, Func: avg},
+	}, flat)
+	// Request is not changed.
+	require.Len(t, aggs[1].SubAggs, 2)
+
+	// Results of the flat aggregations.
+	res := []*seqproxyapi.Aggregation{
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 500}}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Key: "api", Value: 3}, {Key: "db", Value: 1}}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Key: "api", Value: 20}, {Key: "db", Value: 5}}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{
+			{Key: "api, error", Keys: []string{"api", "error"}, Value: 1},
+			{Key: "api, info", Keys: []string{"api", "info"}, Value: 2},
+			{Key: "db, info", Keys: []string{"db", "info"}, Value: 1},
+		}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{
+			{Key: "api, error", Keys: []string{"api", "error"}, Value: 40},
+			{Key: "api, info", Keys: []string{"api", "info"}, Value: 10},
+			{Key: "db, info", Keys: []string{"db", "info"}, Value: 5},
+		}},
+		// Aggregation of 'stats' pipe follows the sub-aggregations.
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 4}}},
+	}
+
+	require.Equal(t, []*seqproxyapi.Aggregation{
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 500}}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{
+			{Key: "api", Value: 3, SubAggs: []*seqproxyapi.Aggregation{
+				{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 20}}},
+				{Buckets: []*seqproxyapi.Aggregation_Bucket{
+					{Key: "error", Value: 1, SubAggs: []*seqproxyapi.Aggregation{
+						{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 40}}},
+					}},
+					{Key: "info", Value: 2, SubAggs: []*seqproxyapi.Aggregation{
+						{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 10}}},
+					}},
+				}},
+			}},
+			{Key: "db", Value: 1, SubAggs: []*seqproxyapi.Aggregation{
+				{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 5}}},
+				{Buckets: []*seqproxyapi.Aggregation_Bucket{
+					{Key: "info", Value: 1, SubAggs: []*seqproxyapi.Aggregation{
+						{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 5}}},
+					}},
+				}},
+			}},
+		}},
+		{Buckets: []*seqproxyapi.Aggregation_Bucket{{Value: 4}}},
+	}, subAggs.nest(res))
+}
+
+func TestFlattenSubAggsBuckets(t *testing.T) {
+	count := seqproxyapi.AggFunc_AGG_FUNC_COUNT
+	avg := seqproxyapi.AggFunc_AGG_FUNC_AVG
+
+	flat, _, err := flattenSubAggs([]*seqproxyapi.AggQuery{{
+		GroupBy: "latency",
+		Func:    count,
+		Ranges:  []float64{100, 500},
+		SubAggs: []*seqproxyapi.AggQuery{
+			{Field: "size", Func: avg},
+			{GroupBy: "service", Func: count},
+		},
+	}})
+	require.NoError(t, err)
+	// Sub-aggregations bucket the field of the parent the same way.
+	require.Equal(t, []*seqproxyapi.AggQuery{
+		{GroupBy: "latency", Func: count, Ranges: []float64{100, 500}},
+		{Field: "size", GroupBy: "latency", Func: avg, Ranges: []float64{100, 500}},
+		{GroupBy: "latency", ThenBy: []string{"service"}, Func: count, Ranges: []float64{100, 500}},
+	}, flat)
+
+	_, _, err = flattenSubAggs([]*seqproxyapi.AggQuery{{
+		GroupBy: "service",
+		Func:    count,
+		SubAggs: []*seqproxyapi.AggQuery{{GroupBy: "latency", Func: count, BinWidth: 100}},
+	}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "'subAggs' do not support buckets")
+}
+
+func TestFlattenSubAggsErrors(t *testing.T) {
+	test := func(agg *seqproxyapi.AggQuery, expected string) {
+		t.Helper()
+		_, _, err := flattenSubAggs([]*seqproxyapi.AggQuery{agg})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), expected)
+	}
+	interval := "1m"
+	avg := &seqproxyapi.AggQuery{Field: "duration", Func: seqproxyapi.AggFunc_AGG_FUNC_AVG}
+
+	test(&seqproxyapi.AggQuery{
+		Field:   "duration",
+		Func:    seqproxyapi.AggFunc_AGG_FUNC_SUM,
+		SubAggs: []*seqproxyapi.AggQuery{avg},
+	}, "'subAggs' require 'groupBy' of the parent aggregation")
+	test(&seqproxyapi.AggQuery{
+		GroupBy:  "service",
+		Func:     seqproxyapi.AggFunc_AGG_FUNC_COUNT,
+		Interval: &interval,
+		SubAggs:  []*seqproxyapi.AggQuery{avg},
+	}, "'subAggs' do not support timeseries")
+	test(&seqproxyapi.AggQuery{
+		GroupBy: "service",
+		Func:    seqproxyapi.AggFunc_AGG_FUNC_COUNT,
+		SubAggs: []*seqproxyapi.AggQuery{{Func: seqproxyapi.AggFunc_AGG_FUNC_UNIQUE}},
+	}, "'groupBy' must be set")
+	test(&seqproxyapi.AggQuery{
+		GroupBy: "a",
+		ThenBy:  []string{"b", "c"},
+		Func:    seqproxyapi.AggFunc_AGG_FUNC_COUNT,
+		SubAggs: []*seqproxyapi.AggQuery{{GroupBy: "d", ThenBy: []string{"e"}, Func: seqproxyapi.AggFunc_AGG_FUNC_COUNT}},
+	}, "grouping by more than 4 fields")
+	test(&seqproxyapi.AggQuery{
+		GroupBy: "service",
+		Func:    seqproxyapi.AggFunc_AGG_FUNC_COUNT,
+		SubAggs: []*seqproxyapi.AggQuery{{
+			Func:    seqproxyapi.AggFunc_AGG_FUNC_COUNT,
+			Filters: []*seqproxyapi.AggFilter{{Name: "errors", Query: "level:error"}},
+		}},
+	}, "'subAggs' do not support 'filters'")
+}
+
+func TestMakeProtoAggregationKeys(t *testing.T) {
+	res := makeProtoAggregation([]seq.AggregationResult{{
+		Buckets: []seq.AggregationBucket{{Name: "api, error", Keys: []string{"api", "error"}, Value: 1}},
+	}})
+	require.Equal(t, []string{"api", "error"}, res[0].Buckets[0].Keys)
+	require.Equal(t, "api, error", res[0].Buckets[0].Key)
+}
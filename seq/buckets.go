@@ -0,0 +1,130 @@
+package seq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxBucketRanges limits the number of the bounds of the ranges.
+	MaxBucketRanges = 1000
+	// boundsError is the relative error of the calculated bounds of the histogram bins,
+	// values which are closer to the bound are in the bin starting from it.
+	boundsError = 1e-14
+)
+
+// NumericBuckets split values of the numeric group field into the buckets, which are the groups of the aggregation.
+// Only one of the ways of the bucketing is set.
+type NumericBuckets struct {
+	// Ranges are the sorted bounds of the ranges [-inf,Ranges[0]), [Ranges[0],Ranges[1]), ..., [Ranges[n-1],+inf).
+	Ranges []float64
+	// BinWidth is the width of the histogram bins [k*BinWidth, (k+1)*BinWidth), the key of the bin is its lower bound.
+	BinWidth float64
+	// BinLogBase is the base of the log-scale histogram bins [BinLogBase^k, BinLogBase^(k+1)),
+	// the key of the bin is its lower bound. Values not greater than zero are in the bin 0.
+	BinLogBase float64
+}
+
+func (b NumericBuckets) IsSet() bool {
+	return len(b.Ranges) > 0 || b.BinWidth != 0 || b.BinLogBase != 0
+}
+
+func (b NumericBuckets) Validate() error {
+	set := 0
+	for _, isSet := range []bool{len(b.Ranges) > 0, b.BinWidth != 0, b.BinLogBase != 0} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return errors.New("only one of ranges, bin width and bin log base can be set")
+	}
+
+	if len(b.Ranges) > MaxBucketRanges {
+		return fmt.Errorf("too many ranges bounds: %d, maximum is %d", len(b.Ranges), MaxBucketRanges)
+	}
+	for i, bound := range b.Ranges {
+		if math.IsNaN(bound) || math.IsInf(bound, 0) {
+			return fmt.Errorf("ranges bound must be finite, got %v", bound)
+		}
+		if i > 0 && b.Ranges[i-1] >= bound {
+			return errors.New("ranges bounds must be sorted in ascending order without repeats")
+		}
+	}
+	if b.BinWidth < 0 || math.IsNaN(b.BinWidth) || math.IsInf(b.BinWidth, 0) {
+		return fmt.Errorf("bin width must be positive, got %v", b.BinWidth)
+	}
+	if b.BinLogBase != 0 && !(b.BinLogBase > 1 && !math.IsInf(b.BinLogBase, 0)) {
+		return fmt.Errorf("bin log base must be greater than 1, got %v", b.BinLogBase)
+	}
+	return nil
+}
+
+// Key returns the key of the bucket of the value.
+func (b NumericBuckets) Key(v float64) string {
+	switch {
+	case len(b.Ranges) > 0:
+		// Index of the first bound greater than the value, it is the upper bound of its range.
+		i, _ := slices.BinarySearch(b.Ranges, v)
+		if i < len(b.Ranges) && b.Ranges[i] == v {
+			i++
+		}
+		lower, upper := "-inf", "+inf"
+		if i > 0 {
+			lower = formatBound(b.Ranges[i-1])
+		}
+		if i < len(b.Ranges) {
+			upper = formatBound(b.Ranges[i])
+		}
+		return "[" + lower + "," + upper + ")"
+
+	case b.BinWidth > 0:
+		k := math.Floor(v / b.BinWidth)
+		// Fix rounding errors of the division near the bounds, e.g. 0.3/0.1 is 2.9999999999999996.
+		tolerance := boundsError * max(math.Abs(v), b.BinWidth)
+		if (k+1)*b.BinWidth <= v+tolerance {
+			k++
+		} else if k*b.BinWidth > v+tolerance {
+			k--
+		}
+		return formatBound(k * b.BinWidth)
+
+	case b.BinLogBase > 0:
+		if v <= 0 {
+			return "0"
+		}
+		k := math.Floor(math.Log(v) / math.Log(b.BinLogBase))
+		// Fix rounding errors of the logarithm and the power near the bounds,
+		// so the powers of the base are the lower bounds of their bins.
+		if math.Pow(b.BinLogBase, k) > v*(1+boundsError) {
+			k--
+		} else if math.Pow(b.BinLogBase, k+1) <= v*(1+boundsError) {
+			k++
+		}
+		return formatBound(math.Pow(b.BinLogBase, k))
+
+	default:
+		panic(errors.New("BUG: buckets are not set"))
+	}
+}
+
+// LowerBound returns the lower bound of the bucket by its key, it is +inf if the key is not the one of the bucket.
+func (b NumericBuckets) LowerBound(key string) float64 {
+	if len(b.Ranges) > 0 {
+		key, _, _ = strings.Cut(strings.TrimPrefix(key, "["), ",")
+	}
+	v, err := strconv.ParseFloat(key, 64)
+	if err != nil {
+		return math.Inf(1)
+	}
+	return v
+}
+
+func formatBound(v float64) string {
+	// Adding zero turns -0 into 0, precision hides floating point errors of the bins bounds like 0.30000000000000004.
+	return strconv.FormatFloat(v+0, 'g', 15, 64)
+}
@@ -0,0 +1,112 @@
+package seq
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericBucketsKey(t *testing.T) {
+	test := func(b NumericBuckets, values map[float64]string) {
+		t.Helper()
+		require.NoError(t, b.Validate())
+		for v, key := range values {
+			assert.Equal(t, key, b.Key(v), "value %v", v)
+			if b.BinLogBase == 0 || v > 0 {
+				assert.LessOrEqual(t, b.LowerBound(key), v+boundsError*max(math.Abs(v), 1), "value %v", v)
+			}
+		}
+	}
+
+	test(NumericBuckets{Ranges: []float64{0, 100, 500}}, map[float64]string{
+		-1:    "[-inf,0)",
+		0:     "[0,100)",
+		99.9:  "[0,100)",
+		100:   "[100,500)",
+		500:   "[500,+inf)",
+		1e100: "[500,+inf)",
+	})
+	test(NumericBuckets{BinWidth: 0.1}, map[float64]string{
+		-0.05: "-0.1",
+		0:     "0",
+		0.3:   "0.3",
+		0.35:  "0.3",
+		0.7:   "0.7",
+		2:     "2",
+	})
+	test(NumericBuckets{BinWidth: 50}, map[float64]string{
+		49:   "0",
+		50:   "50",
+		1234: "1200",
+	})
+	test(NumericBuckets{BinLogBase: 10}, map[float64]string{
+		-5:    "0",
+		0:     "0",
+		0.05:  "0.01",
+		1:     "1",
+		999:   "100",
+		1000:  "1000",
+		1e-5:  "1e-05",
+		1e300: "1e+300",
+	})
+	test(NumericBuckets{BinLogBase: 2}, map[float64]string{
+		3:   "2",
+		4:   "4",
+		100: "64",
+	})
+
+	assert.Equal(t, math.Inf(-1), NumericBuckets{Ranges: []float64{1}}.LowerBound("[-inf,1)"))
+	assert.Equal(t, math.Inf(1), NumericBuckets{BinWidth: 1}.LowerBound("_not_exists"))
+}
+
+func TestNumericBucketsValidate(t *testing.T) {
+	assert.False(t, NumericBuckets{}.IsSet())
+	assert.NoError(t, NumericBuckets{}.Validate())
+
+	for _, b := range []NumericBuckets{
+		{Ranges: []float64{1}, BinWidth: 1},
+		{BinWidth: 1, BinLogBase: 2},
+		{Ranges: []float64{1, 1}},
+		{Ranges: []float64{2, 1}},
+		{Ranges: []float64{math.Inf(1)}},
+		{Ranges: make([]float64, MaxBucketRanges+1)},
+		{BinWidth: -1},
+		{BinWidth: math.NaN()},
+		{BinLogBase: 1},
+		{BinLogBase: math.Inf(1)},
+	} {
+		assert.True(t, b.IsSet())
+		assert.Error(t, b.Validate(), "%+v", b)
+	}
+}
+
+func TestAggregateNumericBuckets(t *testing.T) {
+	b := NumericBuckets{Ranges: []float64{100, 500}}
+	q := AggregatableSamples{SamplesByBin: map[AggBin]*SamplesContainer{
+		{Token: "[500,+inf)"}: {Total: 5},
+		{Token: "[-inf,100)"}: {Total: 1},
+		{Token: "[100,500)"}:  {Total: 10},
+	}}
+
+	res := q.Aggregate(AggregateArgs{Func: AggFuncCount, Buckets: b})
+	assert.Equal(t, []AggregationBucket{
+		{Name: "[-inf,100)", Value: 1},
+		{Name: "[100,500)", Value: 10},
+		{Name: "[500,+inf)", Value: 5},
+	}, res.Buckets)
+
+	// Buckets of the same bound are ordered by the function.
+	q = AggregatableSamples{SamplesByBin: map[AggBin]*SamplesContainer{
+		{Token: JoinGroupTokens([]string{"[500,+inf)", "api"})}: {Total: 1},
+		{Token: JoinGroupTokens([]string{"[100,500)", "api"})}:  {Total: 1},
+		{Token: JoinGroupTokens([]string{"[100,500)", "db"})}:   {Total: 3},
+	}}
+	res = q.Aggregate(AggregateArgs{Func: AggFuncCount, Buckets: b, GroupByFields: 2})
+	names := make([]string, 0, len(res.Buckets))
+	for _, bucket := range res.Buckets {
+		names = append(names, bucket.Name)
+	}
+	assert.Equal(t, []string{"[100,500), db", "[100,500), api", "[500,+inf), api"}, names)
+}
@@ -0,0 +1,72 @@
+package seq
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/util"
+)
+
+// AppendDate appends order-preserving representation of t to dst.
+// Dates are stored as long tokens containing unix time in milliseconds,
+// so the whole range of years accepted by the time formats fits into int64. Sub-millisecond part is truncated.
+func AppendDate(dst []byte, t time.Time) []byte {
+	return AppendLong(dst, DateMillis(t))
+}
+
+// DateMillis returns unix time of t in milliseconds rounded down.
+func DateMillis(t time.Time) int64 {
+	ms := t.UnixMilli()
+	if t.Before(time.UnixMilli(ms)) {
+		// UnixMilli rounds towards zero, so dates before 1970 with sub-millisecond part are rounded up.
+		ms--
+	}
+	return ms
+}
+
+// DecodeDate decodes value encoded with AppendDate.
+func DecodeDate(b []byte) (time.Time, bool) {
+	v, ok := DecodeLong(b)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(v).UTC(), true
+}
+
+// ParseDate parses date in one of consts.TimeFormats.
+func ParseDate(s string) (time.Time, bool) {
+	for _, f := range consts.TimeFormats {
+		if t, err := time.Parse(f, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseDateExpr parses date used in search queries.
+// Besides the formats accepted by ParseDate, it supports relative dates like "now", "now-1h" or "now+1d".
+func ParseDateExpr(s string, now time.Time) (time.Time, bool) {
+	rest, ok := strings.CutPrefix(s, "now")
+	if !ok {
+		return ParseDate(s)
+	}
+	if rest == "" {
+		return now, true
+	}
+
+	sign := time.Duration(1)
+	switch rest[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		return time.Time{}, false
+	}
+
+	d, err := util.ParseDuration(rest[1:])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return now.Add(sign * d), true
+}
@@ -0,0 +1,77 @@
+package seq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	expected := time.Date(2024, 1, 2, 3, 4, 5, 600_000_000, time.UTC)
+	for _, s := range []string{"2024-01-02 03:04:05.6", "2024-01-02T03:04:05.6Z", "2024-01-02T06:04:05.6+03:00"} {
+		date, ok := ParseDate(s)
+		require.True(t, ok, s)
+		assert.True(t, expected.Equal(date), s)
+
+		decoded, ok := DecodeDate(AppendDate(nil, date))
+		require.True(t, ok)
+		assert.True(t, expected.Equal(decoded))
+	}
+
+	for _, s := range []string{"", "now", "2024-01-02", "1704164645"} {
+		_, ok := ParseDate(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestDateBoundaries(t *testing.T) {
+	dates := []time.Time{
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 999_000_000, time.UTC),
+		time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2262, 4, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(9999, 12, 31, 23, 59, 59, 999_000_000, time.UTC),
+	}
+	var prev []byte
+	for _, date := range dates {
+		token := AppendDate(nil, date)
+		decoded, ok := DecodeDate(token)
+		require.True(t, ok)
+		assert.True(t, date.Equal(decoded), "%s: %s", date, decoded)
+		assert.Less(t, string(prev), string(token), date.String())
+		prev = token
+	}
+
+	// Sub-millisecond part is truncated towards the past.
+	date := time.Date(1969, 12, 31, 23, 59, 59, 999_999_999, time.UTC)
+	decoded, _ := DecodeDate(AppendDate(nil, date))
+	assert.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 999_000_000, time.UTC), decoded)
+}
+
+func TestParseDateExpr(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	test := func(s string, expected time.Time) {
+		t.Helper()
+		date, ok := ParseDateExpr(s, now)
+		require.True(t, ok, s)
+		assert.True(t, expected.Equal(date), "%s: %s", s, date)
+	}
+
+	test("now", now)
+	test("now-15m", now.Add(-15*time.Minute))
+	test("now+1d", now.Add(24*time.Hour))
+	test("2024-01-01T00:00:00Z", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, s := range []string{"now-", "now*1h", "now-1x", "yesterday"} {
+		_, ok := ParseDateExpr(s, now)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestFormatDateToken(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.Equal(t, "2024-01-02T03:04:05Z", FormatToken(TokenizerTypeDate, AppendDate(nil, date)))
+}
@@ -0,0 +1,212 @@
+package seq
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	// hllPrecision is the number of the hash bits which select the register,
+	// the standard error of the estimate is 1.04/sqrt(2^hllPrecision), it is about 0.8%.
+	hllPrecision = 14
+	hllRegisters = 1 << hllPrecision
+	// hllMaxRank is the maximum rank stored in the register.
+	hllMaxRank = 64 - hllPrecision + 1
+	// hllSparseLimit is the number of the distinct hashes kept as is before they are counted in the registers,
+	// so the small cardinalities are exact and their sketches are smaller than the registers.
+	hllSparseLimit = hllRegisters / 16
+)
+
+// HashValue returns the hash of the value added to the cardinality sketch.
+// It is the same on all stores, so their sketches are merged.
+func HashValue(value string) uint64 {
+	return xxhash.Sum64String(value)
+}
+
+// CardinalitySketch estimates the number of the distinct values with HyperLogLog.
+// Values are kept as the distinct hashes until there are too many of them,
+// then they are counted in the registers. Sketches are merged without loss of the accuracy.
+type CardinalitySketch struct {
+	// hashes are the hashes of the values, they may repeat until compacted.
+	hashes []uint64
+	// registers are the maximum ranks of the hashes by their registers, nil until the hashes are counted in them.
+	registers []uint8
+}
+
+func NewCardinalitySketch() *CardinalitySketch {
+	return &CardinalitySketch{}
+}
+
+// Add adds the hash of the value returned by HashValue.
+func (s *CardinalitySketch) Add(hash uint64) {
+	if s.registers != nil {
+		s.addRegister(hash)
+		return
+	}
+	s.hashes = append(s.hashes, hash)
+	if len(s.hashes) > 2*hllSparseLimit {
+		s.compact()
+	}
+}
+
+func (s *CardinalitySketch) addRegister(hash uint64) {
+	// Lowest bit of the register index bits bounds the rank.
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	i := hash >> (64 - hllPrecision)
+	s.registers[i] = max(s.registers[i], rank)
+}
+
+// compact removes repeated hashes and counts them in the registers if there are too many of them.
+func (s *CardinalitySketch) compact() {
+	slices.Sort(s.hashes)
+	s.hashes = slices.Compact(s.hashes)
+	if len(s.hashes) <= hllSparseLimit {
+		return
+	}
+	s.registers = make([]uint8, hllRegisters)
+	for _, hash := range s.hashes {
+		s.addRegister(hash)
+	}
+	s.hashes = nil
+}
+
+// Merge adds the values of the other sketch.
+func (s *CardinalitySketch) Merge(other *CardinalitySketch) {
+	if other.registers == nil {
+		for _, hash := range other.hashes {
+			s.Add(hash)
+		}
+		return
+	}
+
+	if s.registers == nil {
+		s.registers = make([]uint8, hllRegisters)
+		for _, hash := range s.hashes {
+			s.addRegister(hash)
+		}
+		s.hashes = nil
+	}
+	for i, rank := range other.registers {
+		s.registers[i] = max(s.registers[i], rank)
+	}
+}
+
+// Estimate returns the estimated number of the distinct values, it is exact for the small cardinalities.
+func (s *CardinalitySketch) Estimate() float64 {
+	if s.registers == nil {
+		s.compact()
+	}
+	if s.registers == nil {
+		return float64(len(s.hashes))
+	}
+
+	// The estimator without empirical bias correction from
+	// "New cardinality estimation algorithms for HyperLogLog sketches" by Otmar Ertl.
+	var counts [hllMaxRank + 1]int
+	for _, rank := range s.registers {
+		counts[rank]++
+	}
+	const m = float64(hllRegisters)
+	z := m * hllTau(1-float64(counts[hllMaxRank])/m)
+	for k := hllMaxRank - 1; k >= 1; k-- {
+		z = 0.5 * (z + float64(counts[k]))
+	}
+	z += m * hllSigma(float64(counts[0])/m)
+	return math.Round(m * m / (2 * math.Ln2 * z))
+}
+
+func hllSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y, z := 1.0, x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if prev == z {
+			return z
+		}
+	}
+}
+
+func hllTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if prev == z {
+			return z / 3
+		}
+	}
+}
+
+const (
+	cardinalitySketchHashes    = byte(0)
+	cardinalitySketchRegisters = byte(1)
+)
+
+// Append appends the binary representation of the sketch to dst.
+func (s *CardinalitySketch) Append(dst []byte) []byte {
+	if s.registers != nil {
+		dst = append(dst, cardinalitySketchRegisters)
+		return append(dst, s.registers...)
+	}
+
+	s.compact()
+	if s.registers != nil {
+		return s.Append(dst)
+	}
+	dst = append(dst, cardinalitySketchHashes)
+	dst = binary.AppendUvarint(dst, uint64(len(s.hashes)))
+	for _, hash := range s.hashes {
+		dst = binary.LittleEndian.AppendUint64(dst, hash)
+	}
+	return dst
+}
+
+// UnmarshalCardinalitySketch decodes the sketch appended by Append and returns the rest of src.
+func UnmarshalCardinalitySketch(src []byte) (*CardinalitySketch, []byte, error) {
+	if len(src) == 0 {
+		return nil, nil, fmt.Errorf("cardinality sketch is empty")
+	}
+	kind := src[0]
+	src = src[1:]
+
+	s := NewCardinalitySketch()
+	switch kind {
+	case cardinalitySketchRegisters:
+		if len(src) < hllRegisters {
+			return nil, nil, fmt.Errorf("cardinality sketch registers are too short: %d bytes", len(src))
+		}
+		s.registers = slices.Clone(src[:hllRegisters])
+		return s, src[hllRegisters:], nil
+
+	case cardinalitySketchHashes:
+		n, l := binary.Uvarint(src)
+		if l <= 0 || n > hllSparseLimit || len(src[l:]) < int(n)*8 {
+			return nil, nil, fmt.Errorf("malformed cardinality sketch hashes: %d", n)
+		}
+		src = src[l:]
+		s.hashes = make([]uint64, n)
+		for i := range s.hashes {
+			s.hashes[i] = binary.LittleEndian.Uint64(src)
+			src = src[8:]
+		}
+		return s, src, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown cardinality sketch kind: %d", kind)
+	}
+}
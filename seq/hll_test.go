@@ -0,0 +1,110 @@
+package seq
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalitySketchExact(t *testing.T) {
+	s := NewCardinalitySketch()
+	assert.Equal(t, float64(0), s.Estimate())
+
+	for i := range 10 * hllSparseLimit {
+		s.Add(HashValue(strconv.Itoa(i % hllSparseLimit)))
+	}
+	// Small cardinalities are counted by the distinct hashes.
+	assert.Nil(t, s.registers)
+	assert.Equal(t, float64(hllSparseLimit), s.Estimate())
+}
+
+func TestCardinalitySketchEstimate(t *testing.T) {
+	for _, n := range []int{hllSparseLimit + 1, 5_000, 20_000, 100_000, 1_000_000} {
+		// Values are split between the sketches like between fractions and stores, some of them repeat.
+		merged := NewCardinalitySketch()
+		for part := 0; part < n; part += 30_000 {
+			s := NewCardinalitySketch()
+			for i := part; i < min(part+40_000, n); i++ {
+				s.Add(HashValue("user" + strconv.Itoa(i)))
+			}
+			merged.Merge(s)
+		}
+		assert.InEpsilon(t, n, merged.Estimate(), 0.03, "cardinality %d", n)
+	}
+}
+
+func TestCardinalitySketchMerge(t *testing.T) {
+	all := NewCardinalitySketch()
+	sparse := NewCardinalitySketch()
+	dense := NewCardinalitySketch()
+	for i := range 10_000 {
+		hash := HashValue(strconv.Itoa(i))
+		all.Add(hash)
+		if i < 100 {
+			sparse.Add(hash)
+		} else {
+			dense.Add(hash)
+		}
+	}
+	require.NotNil(t, dense.registers)
+
+	// Merge result does not depend on the order of the sketches.
+	a := NewCardinalitySketch()
+	a.Merge(sparse)
+	a.Merge(dense)
+	b := NewCardinalitySketch()
+	b.Merge(dense)
+	b.Merge(sparse)
+	assert.Equal(t, all.registers, a.registers)
+	assert.Equal(t, all.registers, b.registers)
+	assert.Equal(t, all.Estimate(), a.Estimate())
+}
+
+func TestCardinalitySketchMarshal(t *testing.T) {
+	test := func(n int) {
+		t.Helper()
+		s := NewCardinalitySketch()
+		for i := range n {
+			s.Add(HashValue(strconv.Itoa(i)))
+		}
+
+		data := s.Append([]byte("prefix"))
+		out, tail, err := UnmarshalCardinalitySketch(append(data[len("prefix"):], "tail"...))
+		require.NoError(t, err)
+		assert.Equal(t, "tail", string(tail))
+		assert.Equal(t, s.Estimate(), out.Estimate())
+
+		_, _, err = UnmarshalCardinalitySketch(data[len("prefix") : len(data)-1])
+		require.Error(t, err)
+	}
+	test(1)
+	test(100)
+	test(100_000)
+
+	out, _, err := UnmarshalCardinalitySketch(NewCardinalitySketch().Append(nil))
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), out.Estimate())
+
+	_, _, err = UnmarshalCardinalitySketch([]byte{2})
+	require.Error(t, err)
+}
+
+func TestSamplesContainerCardinality(t *testing.T) {
+	h := NewSamplesContainers()
+	for _, v := range []string{"a", "b", "a"} {
+		h.Total++
+		h.InsertDistinct(HashValue(v))
+	}
+
+	other := NewSamplesContainers()
+	other.Total++
+	other.InsertDistinct(HashValue("c"))
+	other.Merge(h)
+
+	q := AggregatableSamples{SamplesByBin: map[AggBin]*SamplesContainer{{Token: "api"}: other}}
+	res := q.Aggregate(AggregateArgs{Func: AggFuncCardinality})
+	require.Len(t, res.Buckets, 1)
+	assert.Equal(t, float64(3), res.Buckets[0].Value)
+}
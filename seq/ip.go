@@ -0,0 +1,44 @@
+package seq
+
+import (
+	"net/netip"
+)
+
+// IPTokenSize is the size of binary encoded ip tokens.
+const IPTokenSize = 16
+
+// AppendIP appends fixed-width representation of addr to dst.
+// IPv4 addresses are stored as IPv4-mapped IPv6 ones, so all addresses have the same size
+// and encoded values can be compared with bytes.Compare the same way as the addresses themselves.
+func AppendIP(dst []byte, addr netip.Addr) []byte {
+	b := addr.As16()
+	return append(dst, b[:]...)
+}
+
+// DecodeIP decodes address encoded with AppendIP.
+// IPv4-mapped addresses are returned as IPv4 ones.
+func DecodeIP(b []byte) (netip.Addr, bool) {
+	if len(b) != IPTokenSize {
+		return netip.Addr{}, false
+	}
+	return netip.AddrFrom16([IPTokenSize]byte(b)).Unmap(), true
+}
+
+// ParseIP parses IPv4 or IPv6 address. Addresses with zones are rejected,
+// since zone is not a part of the encoded token.
+func ParseIP(s string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return netip.Addr{}, false
+	}
+	return addr, true
+}
+
+// AppendParsedIP parses text value s and appends its binary representation to dst.
+func AppendParsedIP(dst []byte, s string) ([]byte, bool) {
+	addr, ok := ParseIP(s)
+	if !ok {
+		return dst, false
+	}
+	return AppendIP(dst, addr), true
+}
@@ -0,0 +1,47 @@
+package seq
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPEncoding(t *testing.T) {
+	values := []string{"::", "::1", "0.0.0.0", "10.0.0.1", "192.168.1.1", "255.255.255.255", "2001:db8::1", "ffff::"}
+	for i, v := range values {
+		encoded, ok := AppendParsedIP(nil, v)
+		require.True(t, ok, v)
+		require.Len(t, encoded, IPTokenSize)
+
+		decoded, ok := DecodeIP(encoded)
+		assert.True(t, ok)
+		assert.Equal(t, v, decoded.String())
+
+		if i > 0 {
+			prev, _ := AppendParsedIP(nil, values[i-1])
+			assert.Equal(t, -1, bytes.Compare(prev, encoded))
+		}
+	}
+}
+
+func TestIPNormalization(t *testing.T) {
+	test := func(a, b string) {
+		t.Helper()
+		encodedA, ok := AppendParsedIP(nil, a)
+		require.True(t, ok, a)
+		encodedB, ok := AppendParsedIP(nil, b)
+		require.True(t, ok, b)
+		assert.Equal(t, encodedA, encodedB)
+	}
+
+	test("2001:db8::1", "2001:0db8:0000:0000:0000:0000:0000:0001")
+	test("2001:db8::1", "2001:DB8::1")
+	test("10.0.0.1", "::ffff:10.0.0.1")
+
+	for _, v := range []string{"", "abc", "10.0.0.256", "fe80::1%eth0", "10.0.0.0/8"} {
+		_, ok := AppendParsedIP(nil, v)
+		assert.False(t, ok, v)
+	}
+}
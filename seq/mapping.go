@@ -6,11 +6,23 @@ import (
 	"fmt"
 
 	"gopkg.in/yaml.v2"
+
+	"github.com/ozontech/seq-db/analyzer"
+	"github.com/ozontech/seq-db/consts"
 )
 
 const PathDelim = "."
 
-var TestMapping = Mapping{
+// NgramFieldSuffix is appended to the name of ngram field to get the name of the field with its n-grams.
+// Values of ngram field are indexed as is, like keywords, and n-grams are indexed separately.
+const NgramFieldSuffix = "#ngram"
+
+// NgramField returns the name of the field with n-grams of the given ngram field.
+func NgramField(field string) string {
+	return field + NgramFieldSuffix
+}
+
+var TestMapping = &Mapping{Fields: map[string]MappingTypes{
 	"service":  NewSingleType(TokenizerTypeKeyword, "", 0),
 	"span_id":  NewSingleType(TokenizerTypeKeyword, "", 0),
 	"trace_id": NewSingleType(TokenizerTypeKeyword, "", 0),
@@ -41,7 +53,7 @@ var TestMapping = Mapping{
 	"_exists_":            NewSingleType(TokenizerTypeKeyword, "", 0),
 
 	"m": NewSingleType(TokenizerTypeKeyword, "", 0),
-}
+}}
 
 type MappingFieldType string
 
@@ -49,6 +61,11 @@ const (
 	FieldTypeText    MappingFieldType = "text"
 	FieldTypeKeyword MappingFieldType = "keyword"
 	FieldTypePath    MappingFieldType = "path"
+	FieldTypeLong    MappingFieldType = "long"
+	FieldTypeDouble  MappingFieldType = "double"
+	FieldTypeIP      MappingFieldType = "ip"
+	FieldTypeDate    MappingFieldType = "date"
+	FieldTypeNgram   MappingFieldType = "ngram"
 
 	FieldTypeObject MappingFieldType = "object"
 	FieldTypeTags   MappingFieldType = "tags"
@@ -56,26 +73,79 @@ const (
 )
 
 type MappingTypeIn struct {
-	Title string           `yaml:"title"`
-	Type  MappingFieldType `yaml:"type"`
-	Size  int              `yaml:"size"`
+	Title   string           `yaml:"title"`
+	Type    MappingFieldType `yaml:"type"`
+	Size    int              `yaml:"size"`
+	MinGram int              `yaml:"min_gram"`
+	MaxGram int              `yaml:"max_gram"`
+	// Analyzer is the name of analyzer applied to the words of text field.
+	Analyzer string `yaml:"analyzer"`
+	// CaseSensitive overrides indexing.case_sensitive setting for the field, nil if not set.
+	CaseSensitive *bool `yaml:"case_sensitive"`
 }
 
 type mappingItem struct {
-	Types     []MappingTypeIn  `yaml:"types"`
-	FieldType MappingFieldType `yaml:"type"`
-	FieldName string           `yaml:"name"`
-	Mapping   []mappingItem    `yaml:"mapping-list"`
+	Types         []MappingTypeIn  `yaml:"types"`
+	FieldType     MappingFieldType `yaml:"type"`
+	FieldName     string           `yaml:"name"`
+	Analyzer      string           `yaml:"analyzer"`
+	CaseSensitive *bool            `yaml:"case_sensitive"`
+	Mapping       []mappingItem    `yaml:"mapping-list"`
+}
+
+type analyzerYAML struct {
+	Name           string   `yaml:"name"`
+	Lowercase      bool     `yaml:"lowercase"`
+	ASCIIFolding   bool     `yaml:"ascii_folding"`
+	Stopwords      []string `yaml:"stopwords"`
+	Stemmer        string   `yaml:"stemmer"`
+	MinTokenLength int      `yaml:"min_token_length"`
 }
 
 type mappingYAML struct {
-	Mapping []mappingItem `yaml:"mapping-list"`
+	Analyzers []analyzerYAML `yaml:"analyzers"`
+	Mapping   []mappingItem  `yaml:"mapping-list"`
+	Templates []templateYAML `yaml:"mapping-templates"`
 }
 
 type MappingType struct {
 	Title         string
 	TokenizerType TokenizerType
 	MaxSize       int
+	// MinGram and MaxGram are lengths of n-grams of ngram field, zero means default length.
+	MinGram int
+	MaxGram int
+	// Analyzer is applied to the words of text field both at ingestion and at query time, nil if not set.
+	Analyzer *analyzer.Analyzer
+	// CaseSensitive overrides indexing.case_sensitive setting for the field, nil if not set.
+	CaseSensitive *bool
+}
+
+// IsCaseSensitive returns true if values of the field are indexed as is and false if they are lowercased.
+// Default is indexing.case_sensitive setting which is used for fields that don't override it.
+func (t MappingType) IsCaseSensitive(def bool) bool {
+	if t.Analyzer != nil && t.Analyzer.Lowercase() {
+		return false
+	}
+	if t.CaseSensitive != nil {
+		return *t.CaseSensitive
+	}
+	return def
+}
+
+// GramLengths returns minimal and maximal n-gram lengths of ngram field.
+func (t MappingType) GramLengths() (int, int) {
+	minGram, maxGram := t.MinGram, t.MaxGram
+	if minGram == 0 {
+		minGram = consts.DefaultMinGram
+		if maxGram != 0 {
+			minGram = min(minGram, maxGram)
+		}
+	}
+	if maxGram == 0 {
+		maxGram = max(minGram, consts.DefaultMaxGram)
+	}
+	return minGram, maxGram
 }
 
 type MappingTypes struct {
@@ -85,12 +155,17 @@ type MappingTypes struct {
 	All []MappingType
 }
 
-// Mapping - maps fields to tokenizers. For fields with multiple types there must be a key for each type
-type Mapping map[string]MappingTypes
+// Mapping - maps fields to tokenizers. Nil mapping indexes all fields as keywords.
+type Mapping struct {
+	// Fields - types of the listed fields. For fields with multiple types there must be a key for each type
+	Fields map[string]MappingTypes
+	// Templates assign types to the fields which are not listed, in the order they are declared.
+	Templates []MappingTemplate
+}
 
 type FieldMapping Mapping
 
-func convertMapping(yamlMapping []mappingItem, finalMapping Mapping, path string) error {
+func convertMapping(yamlMapping []mappingItem, finalMapping map[string]MappingTypes, path string, analyzers map[string]*analyzer.Analyzer) error {
 	for _, el := range yamlMapping {
 		fn := el.FieldName
 		if path != "" {
@@ -98,23 +173,34 @@ func convertMapping(yamlMapping []mappingItem, finalMapping Mapping, path string
 		}
 
 		if len(el.Types) > 0 {
-			err := convertMappingWithMultipleTypes(fn, el, finalMapping)
+			err := convertMappingWithMultipleTypes(fn, el, finalMapping, analyzers)
 			if err != nil {
 				return err
 			}
 		} else if el.FieldName != "" {
 			// support old mapping structure
-			if v, ok := NamesToTokenTypes[string(el.FieldType)]; ok {
-				finalMapping[fn] = NewSingleType(v, "", 0)
-			} else {
+			v, ok := NamesToTokenTypes[string(el.FieldType)]
+			if !ok {
 				return fmt.Errorf("unknown field type in mapping: %s", el.FieldType)
 			}
+			a, err := findAnalyzer(fn, el.Analyzer, v, analyzers)
+			if err != nil {
+				return err
+			}
+			if err := checkCaseSensitive(fn, el.CaseSensitive, v, a); err != nil {
+				return err
+			}
+			types := NewSingleType(v, "", 0)
+			types.Main.Analyzer = a
+			types.Main.CaseSensitive = el.CaseSensitive
+			types.All[0] = types.Main
+			finalMapping[fn] = types
 		} else {
 			panic("BUG: no field type in mapping")
 		}
 
 		if el.FieldType == FieldTypeObject || el.FieldType == FieldTypeTags || el.FieldType == FieldTypeNested {
-			if err := convertMapping(el.Mapping, finalMapping, fn); err != nil {
+			if err := convertMapping(el.Mapping, finalMapping, fn, analyzers); err != nil {
 				return err
 			}
 		}
@@ -122,7 +208,7 @@ func convertMapping(yamlMapping []mappingItem, finalMapping Mapping, path string
 	return nil
 }
 
-func convertMappingWithMultipleTypes(fn string, el mappingItem, finalMapping Mapping) error {
+func convertMappingWithMultipleTypes(fn string, el mappingItem, finalMapping map[string]MappingTypes, analyzers map[string]*analyzer.Analyzer) error {
 	types := make([]MappingType, 0, len(el.Types))
 	seen := make(map[string]struct{})
 
@@ -141,18 +227,52 @@ func convertMappingWithMultipleTypes(fn string, el mappingItem, finalMapping Map
 			return fmt.Errorf("unknown field type in mapping: %s", t.Type)
 		}
 
+		if t.MinGram != 0 || t.MaxGram != 0 {
+			if v != TokenizerTypeNgram {
+				return fmt.Errorf("n-gram lengths are allowed only for ngram type: %s", fn)
+			}
+			if t.MinGram < 0 || t.MaxGram < 0 || t.MaxGram != 0 && t.MinGram > t.MaxGram {
+				return fmt.Errorf("invalid n-gram lengths for %s: min_gram=%d, max_gram=%d", fn, t.MinGram, t.MaxGram)
+			}
+		}
+
+		a, err := findAnalyzer(fn, t.Analyzer, v, analyzers)
+		if err != nil {
+			return err
+		}
+
+		caseSensitive := t.CaseSensitive
+		if caseSensitive == nil && IsStringType(v) {
+			// Setting of the field applies to all its string types.
+			caseSensitive = el.CaseSensitive
+		}
+		if err := checkCaseSensitive(fn, caseSensitive, v, a); err != nil {
+			return err
+		}
+
 		seen[t.Title] = struct{}{}
 
+		mappingType := MappingType{
+			TokenizerType: v,
+			MaxSize:       t.Size,
+			MinGram:       t.MinGram,
+			MaxGram:       t.MaxGram,
+			Analyzer:      a,
+			CaseSensitive: caseSensitive,
+		}
+
 		title := t.Title
 		if title == "" {
-			mappingTypes.Main = MappingType{Title: fn, TokenizerType: v, MaxSize: t.Size}
 			title = fn
+			mappingType.Title = title
+			mappingTypes.Main = mappingType
 		} else {
 			title = fn + PathDelim + t.Title
-			finalMapping[title] = NewSingleType(v, title, t.Size)
+			mappingType.Title = title
+			finalMapping[title] = MappingTypes{Main: mappingType, All: []MappingType{mappingType}}
 		}
 
-		types = append(types, MappingType{Title: title, TokenizerType: v, MaxSize: t.Size})
+		types = append(types, mappingType)
 	}
 
 	if mappingTypes.Main.TokenizerType == TokenizerTypeNoop {
@@ -165,26 +285,90 @@ func convertMappingWithMultipleTypes(fn string, el mappingItem, finalMapping Map
 	return nil
 }
 
-func readMapping(mapYAML *mappingYAML, finalMapping Mapping) error {
-	if len(mapYAML.Mapping) == 0 {
+func findAnalyzer(fn, name string, t TokenizerType, analyzers map[string]*analyzer.Analyzer) (*analyzer.Analyzer, error) {
+	if name == "" {
+		return nil, nil
+	}
+	if t != TokenizerTypeText {
+		return nil, fmt.Errorf("analyzer is allowed only for text type: %s", fn)
+	}
+	a, ok := analyzers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown analyzer in mapping: %s", name)
+	}
+	return a, nil
+}
+
+func checkCaseSensitive(fn string, caseSensitive *bool, t TokenizerType, a *analyzer.Analyzer) error {
+	if caseSensitive == nil {
+		return nil
+	}
+	if !IsStringType(t) {
+		return fmt.Errorf("case sensitivity is allowed only for text, keyword, path and ngram types: %s", fn)
+	}
+	if *caseSensitive && a != nil && a.Lowercase() {
+		return fmt.Errorf("case sensitive field can't have lowercase analyzer: %s", fn)
+	}
+	return nil
+}
+
+func readAnalyzers(items []analyzerYAML) (map[string]*analyzer.Analyzer, error) {
+	analyzers := make(map[string]*analyzer.Analyzer, len(items))
+	for _, item := range items {
+		if item.Name == "" {
+			return nil, errors.New("no analyzer name in mapping")
+		}
+		if _, ok := analyzers[item.Name]; ok {
+			return nil, fmt.Errorf("duplicate analyzer in mapping: %s", item.Name)
+		}
+		a, err := analyzer.New(item.Name, analyzer.Config{
+			Lowercase:      item.Lowercase,
+			ASCIIFolding:   item.ASCIIFolding,
+			Stopwords:      item.Stopwords,
+			Stemmer:        item.Stemmer,
+			MinTokenLength: item.MinTokenLength,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid analyzer %s: %w", item.Name, err)
+		}
+		analyzers[item.Name] = a
+	}
+	return analyzers, nil
+}
+
+func readMapping(mapYAML *mappingYAML, finalMapping *Mapping) error {
+	if len(mapYAML.Mapping) == 0 && len(mapYAML.Templates) == 0 {
 		return errors.New("invalid mapping provided")
 	}
 
-	err := convertMapping(mapYAML.Mapping, finalMapping, "")
+	analyzers, err := readAnalyzers(mapYAML.Analyzers)
 	if err != nil {
 		return err
 	}
 
+	err = convertMapping(mapYAML.Mapping, finalMapping.Fields, "", analyzers)
+	if err != nil {
+		return err
+	}
+
+	if len(mapYAML.Templates) > 0 {
+		templates, err := readTemplates(mapYAML.Templates, analyzers)
+		if err != nil {
+			return err
+		}
+		finalMapping.Templates = templates
+	}
+
 	return nil
 }
 
-func ReadMapping(data []byte) (Mapping, error) {
+func ReadMapping(data []byte) (*Mapping, error) {
 	mapYAML := &mappingYAML{}
 	err := yaml.Unmarshal(data, mapYAML)
 	if err != nil {
 		return nil, err
 	}
-	res := Mapping{}
+	res := &Mapping{Fields: map[string]MappingTypes{}}
 	return res, readMapping(mapYAML, res)
 }
 
@@ -192,17 +376,28 @@ type RawMapping struct {
 	rawMapping []byte
 }
 
-func NewRawMapping(mapping Mapping) *RawMapping {
+func NewRawMapping(mapping *Mapping) *RawMapping {
 	return &RawMapping{
 		rawMapping: marshalMapping(mapping),
 	}
 }
 
-func marshalMapping(initialMapping Mapping) []byte {
+// marshalMapping returns index types of the fields as json.
+// Indexed templates are included with their patterns as field names.
+func marshalMapping(initialMapping *Mapping) []byte {
+	if initialMapping == nil {
+		return []byte("{}")
+	}
 	convertedMapping := make(map[string]string)
-	for k, v := range initialMapping {
+	for k, v := range initialMapping.Fields {
 		convertedMapping[k] = TokenTypesToNames[v.Main.TokenizerType]
 	}
+	for _, t := range initialMapping.Templates {
+		if _, ok := convertedMapping[t.Match]; ok || t.Types.Main.TokenizerType == TokenizerTypeNoop {
+			continue
+		}
+		convertedMapping[t.Match] = TokenTypesToNames[t.Types.Main.TokenizerType]
+	}
 	b, err := json.Marshal(convertedMapping)
 	if err != nil {
 		panic(fmt.Errorf("BUG: can't marshal mapping: %s", err))
@@ -215,6 +410,36 @@ func (a *RawMapping) GetRawMappingBytes() []byte {
 	return a.rawMapping
 }
 
+// BinaryFields returns names of index types of the fields which tokens are binary encoded (see IsBinaryType).
+// Nil mapping indexes all fields as keywords, so the result is empty but not nil.
+func BinaryFields(mapping *Mapping) map[string]string {
+	res := make(map[string]string)
+	if mapping == nil {
+		return res
+	}
+	for field, types := range mapping.Fields {
+		if IsBinaryType(types.Main.TokenizerType) {
+			res[field] = TokenTypesToNames[types.Main.TokenizerType]
+		}
+	}
+	return res
+}
+
+// CaseSensitiveFields returns case sensitivity of the fields of string types (see IsStringType).
+// Default is indexing.case_sensitive setting, see MappingType.IsCaseSensitive.
+func CaseSensitiveFields(mapping *Mapping, def bool) map[string]bool {
+	res := make(map[string]bool)
+	if mapping == nil {
+		return res
+	}
+	for field, types := range mapping.Fields {
+		if IsStringType(types.Main.TokenizerType) {
+			res[field] = types.Main.IsCaseSensitive(def)
+		}
+	}
+	return res
+}
+
 func NewSingleType(tokenizerType TokenizerType, title string, maxSize int) MappingTypes {
 	return MappingTypes{
 		Main: MappingType{Title: title, TokenizerType: tokenizerType, MaxSize: maxSize},
@@ -0,0 +1,107 @@
+package seq
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ozontech/seq-db/analyzer"
+)
+
+// MappingTemplate assigns types to the fields which are not listed in the mapping, but match the pattern.
+// Pattern may contain '*' wildcards which match any sequence of characters including dots,
+// e.g. "*_id", "*.duration_ms" or "msg*".
+type MappingTemplate struct {
+	Match string
+	// Types of the matched fields. Main type is TokenizerTypeNoop if the fields are not indexed.
+	Types MappingTypes
+}
+
+type templateYAML struct {
+	Match         string           `yaml:"match"`
+	FieldType     MappingFieldType `yaml:"type"`
+	Analyzer      string           `yaml:"analyzer"`
+	CaseSensitive *bool            `yaml:"case_sensitive"`
+	// Index is false for the fields which are not indexed.
+	Index *bool `yaml:"index"`
+}
+
+// Lookup returns types of the field. Fields which are not listed in the mapping are matched with its templates,
+// the first matching template is used. It returns false if the field is neither listed nor matched.
+func (m *Mapping) Lookup(field string) (MappingTypes, bool) {
+	if m == nil {
+		return MappingTypes{}, false
+	}
+	if types, ok := m.Fields[field]; ok {
+		return types, true
+	}
+	for _, t := range m.Templates {
+		if MatchFieldPattern(t.Match, field) {
+			return t.Types, true
+		}
+	}
+	return MappingTypes{}, false
+}
+
+// MatchFieldPattern checks if the field name matches the pattern of mapping template.
+func MatchFieldPattern(pattern, field string) bool {
+	prefix, rest, found := strings.Cut(pattern, "*")
+	if !found {
+		return pattern == field
+	}
+	if !strings.HasPrefix(field, prefix) {
+		return false
+	}
+	field = field[len(prefix):]
+	for {
+		var part string
+		part, rest, found = strings.Cut(rest, "*")
+		if !found {
+			// The last part must be the suffix of the rest of the field.
+			return len(field) >= len(part) && strings.HasSuffix(field, part)
+		}
+		i := strings.Index(field, part)
+		if i < 0 {
+			return false
+		}
+		field = field[i+len(part):]
+	}
+}
+
+func readTemplates(items []templateYAML, analyzers map[string]*analyzer.Analyzer) ([]MappingTemplate, error) {
+	templates := make([]MappingTemplate, 0, len(items))
+	for _, item := range items {
+		if item.Match == "" {
+			return nil, fmt.Errorf("no pattern in mapping template")
+		}
+
+		if item.Index != nil && !*item.Index {
+			if item.FieldType != "" || item.Analyzer != "" || item.CaseSensitive != nil {
+				return nil, fmt.Errorf("not indexed mapping template can't have type options: %s", item.Match)
+			}
+			templates = append(templates, MappingTemplate{Match: item.Match})
+			continue
+		}
+
+		v, ok := NamesToTokenTypes[string(item.FieldType)]
+		if !ok {
+			return nil, fmt.Errorf("unknown field type in mapping: %s", item.FieldType)
+		}
+		if !IsStringType(v) && !IsBinaryType(v) {
+			return nil, fmt.Errorf("type %s is not allowed in mapping template: %s", item.FieldType, item.Match)
+		}
+		a, err := findAnalyzer(item.Match, item.Analyzer, v, analyzers)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkCaseSensitive(item.Match, item.CaseSensitive, v, a); err != nil {
+			return nil, err
+		}
+
+		types := NewSingleType(v, "", 0)
+		types.Main.Analyzer = a
+		types.Main.CaseSensitive = item.CaseSensitive
+		types.All[0] = types.Main
+		templates = append(templates, MappingTemplate{Match: item.Match, Types: types})
+	}
+	return templates, nil
+}
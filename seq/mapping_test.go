@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -21,7 +22,7 @@ func TestReadMappingWithError(t *testing.T) {
 func TestReadMappingFromFile(t *testing.T) {
 	actual, err := loadMapping("../tests/data/mappings/logging-new.yaml")
 	assert.NoError(t, err)
-	expected := Mapping{
+	expected := &Mapping{Fields: map[string]MappingTypes{
 		"k8s_pod":       NewSingleType(TokenizerTypeKeyword, "", 0),
 		"k8s_namespace": NewSingleType(TokenizerTypeKeyword, "", 0),
 		"k8s_container": NewSingleType(TokenizerTypeKeyword, "", 0),
@@ -38,19 +39,19 @@ func TestReadMappingFromFile(t *testing.T) {
 		"someobj":            NewSingleType(TokenizerTypeObject, "", 0),
 		"someobj.nested":     NewSingleType(TokenizerTypeKeyword, "", 0),
 		"someobj.nestedtext": NewSingleType(TokenizerTypeText, "", 0),
-	}
+	}}
 	assert.Equal(t, expected, actual)
 }
 
 func TestReadMapping(t *testing.T) {
 	testCases := []struct {
 		yamlMapping     *mappingYAML
-		expectedMapping Mapping
+		expectedMapping *Mapping
 		testName        string
 	}{
 		{
 			testName: "read_mapping_1",
-			expectedMapping: Mapping{
+			expectedMapping: &Mapping{Fields: map[string]MappingTypes{
 				"service": NewSingleType(TokenizerTypeKeyword, "", 0),
 				"message": MappingTypes{
 					Main: MappingType{Title: "message", TokenizerType: TokenizerTypeText},
@@ -62,7 +63,7 @@ func TestReadMapping(t *testing.T) {
 				},
 				"message.keyword": NewSingleType(TokenizerTypeKeyword, titleKeyword, 255),
 				"message.path":    NewSingleType(TokenizerTypePath, titlePath, 255),
-			},
+			}},
 			yamlMapping: &mappingYAML{
 				Mapping: []mappingItem{
 					{
@@ -82,12 +83,12 @@ func TestReadMapping(t *testing.T) {
 		},
 		{
 			testName: "read_mapping_nested",
-			expectedMapping: Mapping{
+			expectedMapping: &Mapping{Fields: map[string]MappingTypes{
 				"nested":              NewSingleType(TokenizerTypeObject, "", 0),
 				"nested.field":        NewSingleType(TokenizerTypeText, "", 0),
 				"nested.nested":       NewSingleType(TokenizerTypeObject, "", 0),
 				"nested.nested.field": NewSingleType(TokenizerTypeKeyword, "", 0),
-			},
+			}},
 			yamlMapping: &mappingYAML{
 				Mapping: []mappingItem{
 					{
@@ -115,7 +116,7 @@ func TestReadMapping(t *testing.T) {
 		},
 		{
 			testName: "ignore_old_mapping",
-			expectedMapping: Mapping{
+			expectedMapping: &Mapping{Fields: map[string]MappingTypes{
 				"message": MappingTypes{
 					Main: MappingType{Title: "message", TokenizerType: TokenizerTypeText},
 					All: []MappingType{
@@ -126,7 +127,7 @@ func TestReadMapping(t *testing.T) {
 				},
 				"message.keyword": NewSingleType(TokenizerTypeKeyword, titleKeyword, 255),
 				"message.path":    NewSingleType(TokenizerTypePath, titlePath, 255),
-			},
+			}},
 			yamlMapping: &mappingYAML{
 				Mapping: []mappingItem{
 					{
@@ -141,11 +142,45 @@ func TestReadMapping(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName: "ngram",
+			expectedMapping: &Mapping{Fields: map[string]MappingTypes{
+				"message": MappingTypes{
+					Main: MappingType{Title: "message", TokenizerType: TokenizerTypeText},
+					All: []MappingType{
+						{Title: "message", TokenizerType: TokenizerTypeText},
+						{Title: "message.ngram", TokenizerType: TokenizerTypeNgram, MaxSize: 1024, MinGram: 2, MaxGram: 4},
+					},
+				},
+				"message.ngram": MappingTypes{
+					Main: MappingType{Title: "message.ngram", TokenizerType: TokenizerTypeNgram, MaxSize: 1024, MinGram: 2, MaxGram: 4},
+					All: []MappingType{
+						{Title: "message.ngram", TokenizerType: TokenizerTypeNgram, MaxSize: 1024, MinGram: 2, MaxGram: 4},
+					},
+				},
+				"error": NewSingleType(TokenizerTypeNgram, "", 0),
+			}},
+			yamlMapping: &mappingYAML{
+				Mapping: []mappingItem{
+					{
+						Types: []MappingTypeIn{
+							{Type: FieldTypeText},
+							{Title: "ngram", Type: FieldTypeNgram, Size: 1024, MinGram: 2, MaxGram: 4},
+						},
+						FieldName: "message",
+					},
+					{
+						FieldType: FieldTypeNgram,
+						FieldName: "error",
+					},
+				},
+			},
+		},
 		{
 			testName: "case_sensitivity",
-			expectedMapping: Mapping{
+			expectedMapping: &Mapping{Fields: map[string]MappingTypes{
 				"sErViCe": NewSingleType(TokenizerTypeKeyword, "", 0),
-			},
+			}},
 			yamlMapping: &mappingYAML{
 				Mapping: []mappingItem{
 					{
@@ -159,7 +194,7 @@ func TestReadMapping(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.testName, func(t *testing.T) {
-			m := Mapping{}
+			m := &Mapping{Fields: map[string]MappingTypes{}}
 			assert.NoError(t, readMapping(testCase.yamlMapping, m))
 			assert.Equal(t, testCase.expectedMapping, m)
 		})
@@ -202,6 +237,34 @@ func TestReadMappingError(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("duplicate field title in mapping: message._empty_"),
 		},
+		{
+			testName: "invalid gram lengths",
+			yamlMapping: &mappingYAML{
+				Mapping: []mappingItem{
+					{
+						Types: []MappingTypeIn{
+							{Type: FieldTypeNgram, MinGram: 5, MaxGram: 3},
+						},
+						FieldName: "message",
+					},
+				},
+			},
+			expectedError: fmt.Errorf("invalid n-gram lengths for message: min_gram=5, max_gram=3"),
+		},
+		{
+			testName: "gram lengths of keyword",
+			yamlMapping: &mappingYAML{
+				Mapping: []mappingItem{
+					{
+						Types: []MappingTypeIn{
+							{Type: FieldTypeKeyword, MaxGram: 3},
+						},
+						FieldName: "message",
+					},
+				},
+			},
+			expectedError: fmt.Errorf("n-gram lengths are allowed only for ngram type: message"),
+		},
 		{
 			testName: "unknown field type 1",
 			yamlMapping: &mappingYAML{
@@ -228,6 +291,47 @@ func TestReadMappingError(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("unknown field type in mapping: unknown"),
 		},
+		{
+			testName: "analyzer of keyword",
+			yamlMapping: &mappingYAML{
+				Analyzers: []analyzerYAML{{Name: "english", Stemmer: "english"}},
+				Mapping: []mappingItem{
+					{
+						FieldType: FieldTypeKeyword,
+						FieldName: "service",
+						Analyzer:  "english",
+					},
+				},
+			},
+			expectedError: fmt.Errorf("analyzer is allowed only for text type: service"),
+		},
+		{
+			testName: "unknown analyzer",
+			yamlMapping: &mappingYAML{
+				Mapping: []mappingItem{
+					{
+						Types: []MappingTypeIn{
+							{Type: FieldTypeText, Analyzer: "english"},
+						},
+						FieldName: "message",
+					},
+				},
+			},
+			expectedError: fmt.Errorf("unknown analyzer in mapping: english"),
+		},
+		{
+			testName: "duplicate analyzer",
+			yamlMapping: &mappingYAML{
+				Analyzers: []analyzerYAML{{Name: "english"}, {Name: "english"}},
+				Mapping: []mappingItem{
+					{
+						FieldType: FieldTypeText,
+						FieldName: "message",
+					},
+				},
+			},
+			expectedError: fmt.Errorf("duplicate analyzer in mapping: english"),
+		},
 		{
 			testName: "no main field",
 			yamlMapping: &mappingYAML{
@@ -247,7 +351,7 @@ func TestReadMappingError(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.testName, func(t *testing.T) {
-			m := Mapping{}
+			m := &Mapping{Fields: map[string]MappingTypes{}}
 			err := readMapping(testCase.yamlMapping, m)
 			assert.Error(t, err)
 			assert.Equal(t, testCase.expectedError, err)
@@ -255,7 +359,226 @@ func TestReadMappingError(t *testing.T) {
 	}
 }
 
-func loadMapping(file string) (Mapping, error) {
+func TestReadMappingAnalyzers(t *testing.T) {
+	mapping, err := ReadMapping([]byte(`
+analyzers:
+  - name: logs
+    ascii_folding: true
+    stopwords: [_english_, _russian_]
+    stemmer: russian
+    min_token_length: 2
+mapping-list:
+  - name: message
+    types:
+      - type: text
+        analyzer: logs
+      - title: keyword
+        type: keyword
+  - name: request
+    type: text
+    analyzer: logs
+  - name: service
+    type: keyword
+`))
+	require.NoError(t, err)
+
+	a := mapping.Fields["message"].Main.Analyzer
+	require.NotNil(t, a)
+	assert.Equal(t, "logs", a.Name())
+	assert.Same(t, a, mapping.Fields["message"].All[0].Analyzer)
+	assert.Nil(t, mapping.Fields["message.keyword"].Main.Analyzer)
+	assert.Same(t, a, mapping.Fields["request"].Main.Analyzer)
+	assert.Same(t, a, mapping.Fields["request"].All[0].Analyzer)
+	assert.Nil(t, mapping.Fields["service"].Main.Analyzer)
+
+	_, err = ReadMapping([]byte(`
+analyzers:
+  - name: logs
+    stemmer: french
+mapping-list:
+  - name: message
+    type: text
+`))
+	assert.EqualError(t, err, `invalid analyzer logs: unknown stemmer "french"`)
+}
+
+func TestReadMappingCaseSensitive(t *testing.T) {
+	mapping, err := ReadMapping([]byte(`
+analyzers:
+  - name: logs
+    stemmer: english
+mapping-list:
+  - name: trace_id
+    type: keyword
+    case_sensitive: true
+  - name: level
+    type: keyword
+    case_sensitive: false
+  - name: message
+    case_sensitive: true
+    types:
+      - type: text
+      - title: keyword
+        type: keyword
+      - title: lower
+        type: keyword
+        case_sensitive: false
+  - name: request
+    type: text
+    analyzer: logs
+  - name: bytes
+    type: long
+`))
+	require.NoError(t, err)
+
+	assert.True(t, mapping.Fields["trace_id"].Main.IsCaseSensitive(false))
+	assert.Equal(t, mapping.Fields["trace_id"].Main, mapping.Fields["trace_id"].All[0])
+	assert.False(t, mapping.Fields["level"].Main.IsCaseSensitive(true))
+	assert.True(t, mapping.Fields["message"].Main.IsCaseSensitive(false))
+	assert.True(t, mapping.Fields["message.keyword"].Main.IsCaseSensitive(false))
+	assert.False(t, mapping.Fields["message.lower"].Main.IsCaseSensitive(true))
+	// Analyzer with stemmer lowercases the words.
+	assert.False(t, mapping.Fields["request"].Main.IsCaseSensitive(true))
+
+	assert.Equal(t, map[string]bool{
+		"trace_id":        true,
+		"level":           false,
+		"message":         true,
+		"message.keyword": true,
+		"message.lower":   false,
+		"request":         false,
+	}, CaseSensitiveFields(mapping, false))
+
+	_, err = ReadMapping([]byte(`
+mapping-list:
+  - name: bytes
+    type: long
+    case_sensitive: true
+`))
+	assert.EqualError(t, err, "case sensitivity is allowed only for text, keyword, path and ngram types: bytes")
+
+	_, err = ReadMapping([]byte(`
+analyzers:
+  - name: logs
+    lowercase: true
+mapping-list:
+  - name: message
+    type: text
+    analyzer: logs
+    case_sensitive: true
+`))
+	assert.EqualError(t, err, "case sensitive field can't have lowercase analyzer: message")
+}
+
+func TestMatchFieldPattern(t *testing.T) {
+	tests := []struct {
+		pattern, field string
+		match          bool
+	}{
+		{"user_id", "user_id", true},
+		{"user_id", "user_ids", false},
+		{"*_id", "user_id", true},
+		{"*_id", "request.user_id", true},
+		{"*_id", "_id", true},
+		{"*_id", "user_ids", false},
+		{"msg*", "msg", true},
+		{"msg*", "message", false},
+		{"msg*", "msg.text", true},
+		{"*.duration_ms", "request.duration_ms", true},
+		{"*.duration_ms", "duration_ms", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxcyyb", false},
+		{"a*ab", "aab", true},
+		{"a*ab", "ab", false},
+		{"*", "any.field", true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, MatchFieldPattern(tt.pattern, tt.field), "%s %s", tt.pattern, tt.field)
+	}
+}
+
+func TestReadMappingTemplates(t *testing.T) {
+	mapping, err := ReadMapping([]byte(`
+mapping-list:
+  - name: trace_id
+    type: text
+  - name: _templates_
+    type: keyword
+mapping-templates:
+  - match: "*.payload"
+    index: false
+  - match: "*_id"
+    type: keyword
+    case_sensitive: true
+  - match: "*.duration_ms"
+    type: long
+  - match: "msg*"
+    type: text
+`))
+	require.NoError(t, err)
+	require.Len(t, mapping.Templates, 4)
+
+	lookup := func(field string) TokenizerType {
+		t.Helper()
+		types, ok := mapping.Lookup(field)
+		require.True(t, ok, field)
+		return types.Main.TokenizerType
+	}
+	// Fields of the mapping list are not matched with the templates.
+	assert.Equal(t, TokenizerTypeText, lookup("trace_id"))
+	assert.Equal(t, TokenizerTypeKeyword, lookup("_templates_"))
+	assert.Equal(t, TokenizerTypeKeyword, lookup("user_id"))
+	assert.Equal(t, TokenizerTypeLong, lookup("request.duration_ms"))
+	assert.Equal(t, TokenizerTypeText, lookup("msg"))
+	// The first matching template is used.
+	assert.Equal(t, TokenizerTypeNoop, lookup("request_id.payload"))
+
+	types, _ := mapping.Lookup("user_id")
+	assert.True(t, types.Main.IsCaseSensitive(false))
+
+	_, ok := mapping.Lookup("level")
+	assert.False(t, ok)
+
+	assert.JSONEq(t, `{
+		"trace_id": "text",
+		"_templates_": "keyword",
+		"*_id": "keyword",
+		"*.duration_ms": "long",
+		"msg*": "text"
+	}`, string(NewRawMapping(mapping).GetRawMappingBytes()))
+
+	// Mapping list can be empty if there are templates.
+	_, err = ReadMapping([]byte(`
+mapping-templates:
+  - match: "*"
+    type: keyword
+`))
+	assert.NoError(t, err)
+
+	_, err = ReadMapping([]byte(`
+mapping-templates:
+  - match: "*.tags"
+    type: tags
+`))
+	assert.EqualError(t, err, "type tags is not allowed in mapping template: *.tags")
+
+	_, err = ReadMapping([]byte(`
+mapping-templates:
+  - match: "*.payload"
+    type: text
+    index: false
+`))
+	assert.EqualError(t, err, "not indexed mapping template can't have type options: *.payload")
+
+	_, err = ReadMapping([]byte(`
+mapping-templates:
+  - type: text
+`))
+	assert.EqualError(t, err, "no pattern in mapping template")
+}
+
+func loadMapping(file string) (*Mapping, error) {
 	data, err := os.ReadFile(file)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,146 @@
+package seq
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+)
+
+// NumericTokenSize is the size of binary encoded long and double tokens.
+const NumericTokenSize = 8
+
+const signBit = 1 << 63
+
+// IsNumericType returns true for tokenizer types which values are stored as binary encoded numbers.
+// Dates are stored as long numbers too (see AppendDate).
+func IsNumericType(t TokenizerType) bool {
+	return t == TokenizerTypeLong || t == TokenizerTypeDouble || t == TokenizerTypeDate
+}
+
+// IsBinaryType returns true for tokenizer types which values are stored as binary encoded tokens:
+// numbers, dates and ip addresses.
+func IsBinaryType(t TokenizerType) bool {
+	return IsNumericType(t) || t == TokenizerTypeIP
+}
+
+// AppendLong appends order-preserving representation of v to dst.
+// Encoded values can be compared with bytes.Compare the same way as the numbers themselves.
+func AppendLong(dst []byte, v int64) []byte {
+	return binary.BigEndian.AppendUint64(dst, uint64(v)^signBit)
+}
+
+// DecodeLong decodes value encoded with AppendLong.
+func DecodeLong(b []byte) (int64, bool) {
+	if len(b) != NumericTokenSize {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(b) ^ signBit), true
+}
+
+// AppendDouble appends order-preserving representation of v to dst.
+// Negative numbers have all bits inverted and positive numbers have only the sign bit inverted,
+// so encoded values can be compared with bytes.Compare. NaN and infinities must be filtered out by the caller.
+func AppendDouble(dst []byte, v float64) []byte {
+	if v == 0 {
+		// Merge negative zero with positive one.
+		v = 0
+	}
+	bits := math.Float64bits(v)
+	if bits&signBit != 0 {
+		bits = ^bits
+	} else {
+		bits |= signBit
+	}
+	return binary.BigEndian.AppendUint64(dst, bits)
+}
+
+// DecodeDouble decodes value encoded with AppendDouble.
+func DecodeDouble(b []byte) (float64, bool) {
+	if len(b) != NumericTokenSize {
+		return 0, false
+	}
+	bits := binary.BigEndian.Uint64(b)
+	if bits&signBit != 0 {
+		bits &^= signBit
+	} else {
+		bits = ^bits
+	}
+	return math.Float64frombits(bits), true
+}
+
+// DecodeNumeric decodes binary token of the given numeric type to float64.
+func DecodeNumeric(t TokenizerType, b []byte) (float64, bool) {
+	switch t {
+	case TokenizerTypeLong:
+		v, ok := DecodeLong(b)
+		return float64(v), ok
+	case TokenizerTypeDouble:
+		return DecodeDouble(b)
+	default:
+		return 0, false
+	}
+}
+
+// FormatNumeric returns human-readable representation of binary token of the given numeric type.
+// Tokens of other types are returned as is.
+func FormatNumeric(t TokenizerType, b []byte) string {
+	switch t {
+	case TokenizerTypeLong:
+		if v, ok := DecodeLong(b); ok {
+			return strconv.FormatInt(v, 10)
+		}
+	case TokenizerTypeDouble:
+		if v, ok := DecodeDouble(b); ok {
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+	}
+	return string(b)
+}
+
+// ParseLong parses long value from its text representation.
+// Values like "42.0" or "1e3" are accepted if they fit into int64 without losing precision.
+func ParseLong(s string) (int64, bool) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// ParseDouble parses double value from its text representation. NaN and infinities are rejected.
+func ParseDouble(s string) (float64, bool) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// AppendNumeric parses text value s according to the numeric type t and appends its binary representation to dst.
+func AppendNumeric(dst []byte, t TokenizerType, s string) ([]byte, bool) {
+	switch t {
+	case TokenizerTypeLong:
+		v, ok := ParseLong(s)
+		if !ok {
+			return dst, false
+		}
+		return AppendLong(dst, v), true
+	case TokenizerTypeDouble:
+		v, ok := ParseDouble(s)
+		if !ok {
+			return dst, false
+		}
+		return AppendDouble(dst, v), true
+	case TokenizerTypeDate:
+		v, ok := ParseDate(s)
+		if !ok {
+			return dst, false
+		}
+		return AppendDate(dst, v), true
+	default:
+		return dst, false
+	}
+}
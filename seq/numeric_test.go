@@ -0,0 +1,57 @@
+package seq
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongEncoding(t *testing.T) {
+	values := []int64{math.MinInt64, -1000, -1, 0, 1, 42, 1000, math.MaxInt64}
+	for i, v := range values {
+		encoded := AppendLong(nil, v)
+		decoded, ok := DecodeLong(encoded)
+		assert.True(t, ok)
+		assert.Equal(t, v, decoded)
+
+		if i > 0 {
+			assert.Equal(t, -1, bytes.Compare(AppendLong(nil, values[i-1]), encoded))
+		}
+	}
+}
+
+func TestDoubleEncoding(t *testing.T) {
+	values := []float64{-math.MaxFloat64, -1e10, -1.5, -math.SmallestNonzeroFloat64, 0, math.SmallestNonzeroFloat64, 0.5, 1, 1e10, math.MaxFloat64}
+	for i, v := range values {
+		encoded := AppendDouble(nil, v)
+		decoded, ok := DecodeDouble(encoded)
+		assert.True(t, ok)
+		assert.Equal(t, v, decoded)
+
+		if i > 0 {
+			assert.Equal(t, -1, bytes.Compare(AppendDouble(nil, values[i-1]), encoded))
+		}
+	}
+
+	assert.Equal(t, AppendDouble(nil, 0), AppendDouble(nil, math.Copysign(0, -1)))
+}
+
+func TestParseLong(t *testing.T) {
+	v, ok := ParseLong("1e3")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1000), v)
+
+	_, ok = ParseLong("1.5")
+	assert.False(t, ok)
+
+	_, ok = ParseLong("1e100")
+	assert.False(t, ok)
+}
+
+func TestFormatNumeric(t *testing.T) {
+	assert.Equal(t, "-42", FormatNumeric(TokenizerTypeLong, AppendLong(nil, -42)))
+	assert.Equal(t, "0.25", FormatNumeric(TokenizerTypeDouble, AppendDouble(nil, 0.25)))
+	assert.Equal(t, "abc", FormatNumeric(TokenizerTypeKeyword, []byte("abc")))
+}
@@ -2,12 +2,12 @@ package seq
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"slices"
 	"sort"
-
-	"github.com/valyala/fastrand"
+	"strings"
 
 	"github.com/ozontech/seq-db/consts"
 	"github.com/ozontech/seq-db/metric"
@@ -28,10 +28,26 @@ func (o DocsOrder) IsReverse() bool {
 	return o == DocsOrderAsc
 }
 
+// SortField is a field which indexed values order the documents instead of their time.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// SortValue is an indexed value of the sort field of the document.
+// Numeric values are binary encoded preserving their order, so values of any type are compared as bytes.
+type SortValue struct {
+	Value string
+	// Missing is true if the document has no such field, these documents are the last ones in any order.
+	Missing bool
+}
+
 type IDSource struct {
 	ID     ID
 	Source uint64
 	Hint   string
+	// SortValues are values of the sort fields, they are set only if the documents are sorted by fields.
+	SortValues []SortValue
 }
 
 func (id *IDSource) Equal(check IDSource) bool {
@@ -40,6 +56,45 @@ func (id *IDSource) Equal(check IDSource) bool {
 
 type IDSources []IDSource
 
+// CompareSorted compares the documents by values of the sort fields.
+// Documents with the same values are ordered by time according to order.
+func CompareSorted(a, b IDSource, sortBy []SortField, order DocsOrder) int {
+	if c := CompareSortValues(a.SortValues, b.SortValues, sortBy); c != 0 {
+		return c
+	}
+	c := cmp.Or(cmp.Compare(a.ID.MID, b.ID.MID), cmp.Compare(a.ID.RID, b.ID.RID))
+	if order.IsReverse() {
+		return c
+	}
+	return -c
+}
+
+// CompareSortValues compares values of the sort fields of two documents.
+func CompareSortValues(a, b []SortValue, sortBy []SortField) int {
+	for i, f := range sortBy {
+		if c := compareSortValues(a[i], b[i], f.Desc); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareSortValues(a, b SortValue, desc bool) int {
+	switch {
+	case a.Missing && b.Missing:
+		return 0
+	case a.Missing:
+		// Documents without the field are the last ones regardless of the direction.
+		return 1
+	case b.Missing:
+		return -1
+	case desc:
+		return strings.Compare(b.Value, a.Value)
+	default:
+		return strings.Compare(a.Value, b.Value)
+	}
+}
+
 func (p IDSources) Len() int           { return len(p) }
 func (p IDSources) Less(i, j int) bool { return Less(p[i].ID, p[j].ID) }
 func (p IDSources) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
@@ -103,13 +158,58 @@ const (
 	AggFuncAvg
 	AggFuncQuantile
 	AggFuncUnique
+	// AggFuncCardinality estimates the number of distinct values of the field.
+	AggFuncCardinality
 )
 
 type AggBin struct {
-	MID   MID
+	MID MID
+	// Token is the value of the group field, values of multiple group fields are joined by JoinGroupTokens.
 	Token string
 }
 
+// MaxGroupByFields is the maximum number of fields grouped by together.
+const MaxGroupByFields = 4
+
+// MaxAggFilters is the maximum number of the filters of the filters aggregation.
+const MaxAggFilters = 100
+
+// JoinGroupTokens joins the values of the fields grouped by together into the single token of AggBin.
+// The only value is the token itself, so grouping by single field is not affected.
+// Multiple values are prefixed with their lengths, so they may contain any symbols.
+func JoinGroupTokens(tokens []string) string {
+	if len(tokens) == 1 {
+		return tokens[0]
+	}
+	size := 0
+	for _, t := range tokens {
+		size += binary.MaxVarintLen32 + len(t)
+	}
+	buf := make([]byte, 0, size)
+	for _, t := range tokens {
+		buf = binary.AppendUvarint(buf, uint64(len(t)))
+		buf = append(buf, t...)
+	}
+	return string(buf)
+}
+
+// SplitGroupTokens splits the token joined by JoinGroupTokens into n values.
+func SplitGroupTokens(token string, n int) ([]string, bool) {
+	if n == 1 {
+		return []string{token}, true
+	}
+	tokens := make([]string, 0, n)
+	for range n {
+		size, l := binary.Uvarint([]byte(token))
+		if l <= 0 || uint64(len(token)-l) < size {
+			return nil, false
+		}
+		tokens = append(tokens, token[l:l+int(size)])
+		token = token[l+int(size):]
+	}
+	return tokens, token == ""
+}
+
 type AggregatableSamples struct {
 	SamplesByBin map[AggBin]*SamplesContainer
 	NotExists    int64
@@ -121,6 +221,11 @@ type AggregationBucket struct {
 	Quantiles []float64
 	NotExists int64
 	MID       MID
+	// Percent is the share of the bucket in the total count, it is set only for 'top' and 'rare' pipes.
+	Percent float64
+	// Keys are the values of the group fields, they are set only for grouping by multiple fields.
+	// Name joins them with ", " then.
+	Keys []string
 }
 
 type AggregationResult struct {
@@ -132,6 +237,12 @@ type AggregateArgs struct {
 	Func                 AggFunc
 	SkipWithoutTimestamp bool
 	Quantiles            []float64
+	// GroupByFields is the number of the fields grouped by together, their values are split into bucket keys.
+	GroupByFields int
+	// Buckets are set if the values of the first group field are bucketed, buckets are ordered by their bounds then.
+	Buckets NumericBuckets
+	// Filters are the names of the filters of the filters aggregation, buckets are ordered like the filters then.
+	Filters []string
 }
 
 func (q *AggregatableSamples) Aggregate(args AggregateArgs) AggregationResult {
@@ -145,6 +256,12 @@ func (q *AggregatableSamples) Aggregate(args AggregateArgs) AggregationResult {
 	}
 
 	sortBuckets(args.Func, buckets)
+	if args.Buckets.IsSet() {
+		sortByBounds(args.Buckets, buckets)
+	}
+	if len(args.Filters) > 0 {
+		sortByFilters(args.Filters, buckets)
+	}
 
 	return AggregationResult{
 		Buckets:   buckets,
@@ -152,6 +269,33 @@ func (q *AggregatableSamples) Aggregate(args AggregateArgs) AggregationResult {
 	}
 }
 
+// sortByBounds orders buckets by the lower bounds of the numeric buckets of the first group field,
+// the order of the buckets with the same bounds is kept.
+func sortByBounds(numericBuckets NumericBuckets, buckets []AggregationBucket) {
+	lowerBound := func(b AggregationBucket) float64 {
+		if len(b.Keys) > 0 {
+			return numericBuckets.LowerBound(b.Keys[0])
+		}
+		return numericBuckets.LowerBound(b.Name)
+	}
+	slices.SortStableFunc(buckets, func(left, right AggregationBucket) int {
+		return cmp.Or(
+			cmp.Compare(left.MID, right.MID),
+			cmp.Compare(lowerBound(left), lowerBound(right)),
+		)
+	})
+}
+
+// sortByFilters orders buckets of the filters aggregation like the filters of the query.
+func sortByFilters(filters []string, buckets []AggregationBucket) {
+	slices.SortStableFunc(buckets, func(left, right AggregationBucket) int {
+		return cmp.Or(
+			cmp.Compare(left.MID, right.MID),
+			cmp.Compare(slices.Index(filters, left.Name), slices.Index(filters, right.Name)),
+		)
+	})
+}
+
 func sortBuckets(aggFunc AggFunc, buckets []AggregationBucket) {
 	sortByValueDescNameAsc := func(left, right AggregationBucket) int {
 		return cmp.Or(
@@ -210,6 +354,10 @@ func (q *AggregatableSamples) getAggBucket(bin AggBin, hist *SamplesContainer, a
 		if hist.Total != 0 {
 			value = hist.Sum / float64(hist.Total)
 		}
+	case AggFuncCardinality:
+		if hist.Cardinality != nil {
+			value = hist.Cardinality.Estimate()
+		}
 	case AggFuncQuantile:
 		if len(args.Quantiles) == 0 {
 			panic(fmt.Errorf("BUG: empty quantiles"))
@@ -223,17 +371,24 @@ func (q *AggregatableSamples) getAggBucket(bin AggBin, hist *SamplesContainer, a
 		panic(fmt.Errorf("unimplemented aggregation func"))
 	}
 
-	if hist.Total == 0 && args.Func != AggFuncCount && args.Func != AggFuncUnique {
+	if hist.Total == 0 && args.Func != AggFuncCount && args.Func != AggFuncUnique && args.Func != AggFuncCardinality {
 		value = math.NaN()
 	}
 
-	return AggregationBucket{
+	bucket := AggregationBucket{
 		Name:      bin.Token,
 		MID:       bin.MID,
 		Value:     value,
 		Quantiles: quantiles,
 		NotExists: hist.NotExists,
 	}
+	if args.GroupByFields > 1 {
+		if keys, ok := SplitGroupTokens(bin.Token, args.GroupByFields); ok {
+			bucket.Name = strings.Join(keys, ", ")
+			bucket.Keys = keys
+		}
+	}
+	return bucket
 }
 
 func (q *AggregatableSamples) Merge(agg AggregatableSamples) {
@@ -252,10 +407,7 @@ func (q *AggregatableSamples) Merge(agg AggregatableSamples) {
 }
 
 // SamplesContainer is a container that is used for aggregations.
-// Implements reservoir sampling algorithm.
 type SamplesContainer struct {
-	rng fastrand.RNG
-
 	Min float64
 	Max float64
 	Sum float64
@@ -263,30 +415,28 @@ type SamplesContainer struct {
 	Total int64
 	// NotExists is the number of values without a token.
 	NotExists int64
-	Samples   []float64
+	// Sketch estimates quantiles of the inserted samples, it is nil if samples are not collected.
+	Sketch *QuantileSketch
+	// Cardinality estimates the number of distinct values, it is nil unless they are counted.
+	Cardinality *CardinalitySketch
 }
 
 func NewSamplesContainers() *SamplesContainer {
-	h := &SamplesContainer{
+	return &SamplesContainer{
 		Min: math.MaxInt64,
 		Max: math.MinInt64,
 	}
-	// Fixed seed to have same result on the same input
-	h.rng.Seed(73)
-	return h
 }
 
 // Quantile calculates the quantile value of the histogram.
 // The argument should be in [0, 1] range.
-//
-// The implementation is taken and adapted from github.com/valyala/histogram.
 func (h *SamplesContainer) Quantile(quantile float64) float64 {
 	if quantile < 0 || quantile > 1 {
 		// Must be checked in seqproxy
 		panic(fmt.Errorf("BUG: invalid quantile: %f", quantile))
 	}
 
-	if len(h.Samples) == 0 {
+	if h.Total == 0 {
 		return math.NaN()
 	}
 	if quantile == 1 {
@@ -295,10 +445,12 @@ func (h *SamplesContainer) Quantile(quantile float64) float64 {
 	if quantile == 0 {
 		return h.Min
 	}
+	if h.Sketch == nil {
+		return math.NaN()
+	}
 
-	slices.Sort(h.Samples)
-	index := int(float64(len(h.Samples)-1)*quantile + 0.5) // +0.5 to round up value
-	return h.Samples[index]
+	// Estimate can be out of the range of the values within the relative error.
+	return min(max(h.Sketch.Quantile(quantile), h.Min), h.Max)
 }
 
 func (h *SamplesContainer) Merge(hist *SamplesContainer) {
@@ -319,8 +471,18 @@ func (h *SamplesContainer) Merge(hist *SamplesContainer) {
 	h.Sum += hist.Sum
 	h.Total += hist.Total
 
-	for _, v := range hist.Samples {
-		h.InsertSample(v)
+	if hist.Sketch != nil {
+		if h.Sketch == nil {
+			h.Sketch = NewQuantileSketch(hist.Sketch.RelativeError())
+		}
+		h.Sketch.Merge(hist.Sketch)
+	}
+
+	if hist.Cardinality != nil {
+		if h.Cardinality == nil {
+			h.Cardinality = NewCardinalitySketch()
+		}
+		h.Cardinality.Merge(hist.Cardinality)
 	}
 }
 
@@ -336,23 +498,25 @@ func (h *SamplesContainer) InsertNTimes(num float64, cnt int64) {
 	h.Total += cnt
 }
 
-func (h *SamplesContainer) InsertSampleNTimes(sample float64, cnt int64) {
-	for i := int64(0); i < cnt; i++ {
-		h.InsertSample(sample)
+// InsertDistinct adds the hash of the value returned by HashValue to the cardinality sketch.
+func (h *SamplesContainer) InsertDistinct(hash uint64) {
+	if h.Cardinality == nil {
+		h.Cardinality = NewCardinalitySketch()
 	}
+	h.Cardinality.Add(hash)
 }
 
-const maxHistogramSamples = 8096
-
-func (h *SamplesContainer) InsertSample(num float64) {
-	if len(h.Samples) < maxHistogramSamples {
-		h.Samples = append(h.Samples, num)
-	} else {
-		h.Samples[h.rng.Uint32()%maxHistogramSamples] = num
+// InsertSampleNTimes adds the sample to the quantile sketch cnt times,
+// the sketch is created with the relative error on the first sample.
+func (h *SamplesContainer) InsertSampleNTimes(sample float64, cnt int64, relativeError float64) {
+	if h.Sketch == nil {
+		h.Sketch = NewQuantileSketch(relativeError)
 	}
+	h.Sketch.Add(sample, cnt)
 }
 
-func MergeQPRs(dst *QPR, qprs []*QPR, limit int, histInterval MID, order DocsOrder) {
+// MergeQPRs merges qprs into dst keeping limit ids ordered by time or by sort fields if they are set.
+func MergeQPRs(dst *QPR, qprs []*QPR, limit int, histInterval MID, order DocsOrder, sortBy []SortField) {
 	idsCount := 0
 	for _, qpr := range qprs {
 		idsCount += len(qpr.IDs)
@@ -380,7 +544,10 @@ func MergeQPRs(dst *QPR, qprs []*QPR, limit int, histInterval MID, order DocsOrd
 		dst.Errors = append(dst.Errors, qpr.Errors...)
 	}
 
-	if order.IsReverse() {
+	if len(sortBy) > 0 {
+		// Copies of the same document have the same sort values, so they are adjacent to be removed below.
+		slices.SortFunc(dst.IDs, func(a, b IDSource) int { return CompareSorted(a, b, sortBy, order) })
+	} else if order.IsReverse() {
 		sort.Sort(dst.IDs)
 	} else {
 		// it is not a bug: regular order is descending
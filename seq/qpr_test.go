@@ -2,6 +2,7 @@ package seq
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -330,7 +331,7 @@ func TestMergeQPRs(t *testing.T) {
 				Histogram: make(map[MID]uint64),
 				Aggs:      make([]AggregatableSamples, len(test.exp.Aggs)),
 			}
-			MergeQPRs(result, test.qprs, test.limit, test.histInterval, DocsOrderDesc)
+			MergeQPRs(result, test.qprs, test.limit, test.histInterval, DocsOrderDesc, nil)
 
 			a.Equal(result.Histogram, test.exp.Histogram)
 			a.Equal(result.IDs, test.exp.IDs)
@@ -348,7 +349,7 @@ func TestMergeQPRs(t *testing.T) {
 				Aggs:      make([]AggregatableSamples, len(test.exp.Aggs)),
 			}
 			for _, qpr := range test.qprs {
-				MergeQPRs(result, []*QPR{qpr}, test.limit, test.histInterval, DocsOrderDesc)
+				MergeQPRs(result, []*QPR{qpr}, test.limit, test.histInterval, DocsOrderDesc, nil)
 			}
 			a.Equal(result.Histogram, test.exp.Histogram)
 			a.Equal(result.IDs, test.exp.IDs)
@@ -359,6 +360,74 @@ func TestMergeQPRs(t *testing.T) {
 	}
 }
 
+func TestJoinGroupTokens(t *testing.T) {
+	// Single value is the token itself.
+	assert.Equal(t, "api", JoinGroupTokens([]string{"api"}))
+
+	for _, tokens := range [][]string{
+		{"api", "error"},
+		{"", "a, b", ""},
+		{"\x01", string(make([]byte, 300)), "end"},
+	} {
+		token := JoinGroupTokens(tokens)
+		split, ok := SplitGroupTokens(token, len(tokens))
+		assert.True(t, ok)
+		assert.Equal(t, tokens, split)
+	}
+
+	_, ok := SplitGroupTokens(JoinGroupTokens([]string{"a", "b"}), 3)
+	assert.False(t, ok)
+	_, ok = SplitGroupTokens(JoinGroupTokens([]string{"a", "b", "c"}), 2)
+	assert.False(t, ok)
+	_, ok = SplitGroupTokens("\x05ab", 2)
+	assert.False(t, ok)
+
+	// Buckets of the tuples have the values of the fields as keys.
+	samples := AggregatableSamples{SamplesByBin: map[AggBin]*SamplesContainer{
+		{Token: JoinGroupTokens([]string{"api", "error"})}: {Total: 2},
+	}}
+	assert.Equal(t, []AggregationBucket{
+		{Name: "api, error", Value: 2, Keys: []string{"api", "error"}},
+	}, samples.Aggregate(AggregateArgs{Func: AggFuncCount, GroupByFields: 2}).Buckets)
+}
+
+func TestMergeQPRsSorted(t *testing.T) {
+	val := func(v string) []SortValue { return []SortValue{{Value: v}} }
+	missing := []SortValue{{Missing: true}}
+
+	qprs := []*QPR{
+		{IDs: IDSources{
+			{ID: ID{MID: 5}, SortValues: val("b")},
+			{ID: ID{MID: 1}, SortValues: val("c")},
+			{ID: ID{MID: 7}, SortValues: missing},
+		}},
+		{IDs: IDSources{
+			{ID: ID{MID: 3}, SortValues: val("a")},
+			{ID: ID{MID: 6}, SortValues: val("b")},
+			// Copy of the document from the other replica.
+			{ID: ID{MID: 1}, SortValues: val("c")},
+		}},
+	}
+
+	test := func(sortBy []SortField, order DocsOrder, limit int, expected []MID) {
+		t.Helper()
+		dst := &QPR{Histogram: make(map[MID]uint64)}
+		for _, qpr := range qprs {
+			MergeQPRs(dst, []*QPR{{IDs: slices.Clone(qpr.IDs)}}, limit, 0, order, sortBy)
+		}
+		mids := make([]MID, 0, len(dst.IDs))
+		for _, id := range dst.IDs {
+			mids = append(mids, id.ID.MID)
+		}
+		assert.Equal(t, expected, mids)
+	}
+
+	test([]SortField{{Field: "f"}}, DocsOrderDesc, 10, []MID{3, 6, 5, 1, 7})
+	test([]SortField{{Field: "f"}}, DocsOrderAsc, 10, []MID{3, 5, 6, 1, 7})
+	test([]SortField{{Field: "f", Desc: true}}, DocsOrderDesc, 10, []MID{1, 6, 5, 3, 7})
+	test([]SortField{{Field: "f", Desc: true}}, DocsOrderDesc, 2, []MID{1, 6})
+}
+
 func TestRemoveRepetitionsAdvancedForIds(t *testing.T) {
 	testList := []struct {
 		name                string
@@ -531,7 +600,7 @@ func BenchmarkMergeQPRs_ReusingQPR(b *testing.B) {
 	}
 
 	for range b.N {
-		MergeQPRs(&aggQpr, qprs, 1000, 5, DocsOrderDesc)
+		MergeQPRs(&aggQpr, qprs, 1000, 5, DocsOrderDesc, nil)
 
 		aggQpr.IDs = aggQpr.IDs[:0]
 		aggQpr.Errors = aggQpr.Errors[:0]
@@ -0,0 +1,263 @@
+package seq
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultQuantileError is the relative error of quantiles if it is not set in the request.
+	DefaultQuantileError = 0.01
+	// MinQuantileError and MaxQuantileError limit the relative error of quantiles set in the request.
+	MinQuantileError = 0.005
+	MaxQuantileError = 0.5
+
+	// maxSketchBuckets limits the number of buckets of the sketch store,
+	// the lowest buckets are collapsed if values span more of them.
+	// It is about 70 orders of magnitude for the default relative error and 35 ones for the minimal error.
+	maxSketchBuckets = 8192
+	// minSketchValue is the smallest absolute value which is indexed,
+	// values closer to zero are counted as zeros.
+	minSketchValue = 0x1p-1022
+)
+
+// QuantileSketch estimates quantiles with the relative error guarantee,
+// the estimate of the quantile value x is within x·(1±RelativeError).
+// It is the DDSketch (https://arxiv.org/abs/1908.10693): values are counted in the buckets
+// of exponentially growing width, so sketches are merged without loss of the accuracy.
+type QuantileSketch struct {
+	relativeError float64
+	gamma         float64
+	logGamma      float64
+
+	positive sketchStore
+	// negative counts absolute values of the negative ones.
+	negative sketchStore
+	zeros    int64
+	count    int64
+}
+
+func NewQuantileSketch(relativeError float64) *QuantileSketch {
+	if relativeError <= 0 || relativeError >= 1 {
+		panic(fmt.Errorf("BUG: invalid relative error: %f", relativeError))
+	}
+	gamma := (1 + relativeError) / (1 - relativeError)
+	return &QuantileSketch{
+		relativeError: relativeError,
+		gamma:         gamma,
+		logGamma:      math.Log(gamma),
+	}
+}
+
+func (s *QuantileSketch) RelativeError() float64 {
+	return s.relativeError
+}
+
+// Count returns the number of the added values.
+func (s *QuantileSketch) Count() int64 {
+	return s.count
+}
+
+// Add adds the value cnt times.
+func (s *QuantileSketch) Add(v float64, cnt int64) {
+	if math.IsNaN(v) || cnt <= 0 {
+		return
+	}
+	s.count += cnt
+	switch {
+	case v >= minSketchValue:
+		s.positive.add(s.index(v), cnt)
+	case v <= -minSketchValue:
+		s.negative.add(s.index(-v), cnt)
+	default:
+		s.zeros += cnt
+	}
+}
+
+// index returns the index of the bucket (gamma^(index-1), gamma^index] containing the positive value.
+func (s *QuantileSketch) index(v float64) int32 {
+	return int32(math.Ceil(math.Log(min(v, math.MaxFloat64)) / s.logGamma))
+}
+
+// value returns the value of the bucket which is within the relative error of all its values.
+func (s *QuantileSketch) value(index int32) float64 {
+	return 2 * math.Pow(s.gamma, float64(index)) / (s.gamma + 1)
+}
+
+// Quantile returns the estimate of the quantile in [0, 1] range, it is NaN for the empty sketch.
+func (s *QuantileSketch) Quantile(q float64) float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+
+	// Rank is rounded to the nearest value the same way as for the raw samples.
+	rank := math.Floor(q*float64(s.count-1) + 0.5)
+	n := float64(0)
+	for i := len(s.negative.counts) - 1; i >= 0; i-- {
+		n += float64(s.negative.counts[i])
+		if n > rank {
+			return -s.value(s.negative.offset + int32(i))
+		}
+	}
+	n += float64(s.zeros)
+	if n > rank {
+		return 0
+	}
+	for i, c := range s.positive.counts {
+		n += float64(c)
+		if n > rank {
+			return s.value(s.positive.offset + int32(i))
+		}
+	}
+	return s.value(s.positive.offset + int32(len(s.positive.counts)-1))
+}
+
+// Merge adds the values of the other sketch. Buckets are merged as is if relative errors are equal,
+// otherwise their values are added keeping the error of the other sketch.
+func (s *QuantileSketch) Merge(other *QuantileSketch) {
+	s.zeros += other.zeros
+	if s.relativeError == other.relativeError {
+		s.count += other.count
+		s.positive.merge(&other.positive)
+		s.negative.merge(&other.negative)
+		return
+	}
+
+	s.count += other.zeros
+	for i, c := range other.positive.counts {
+		s.Add(other.value(other.positive.offset+int32(i)), c)
+	}
+	for i, c := range other.negative.counts {
+		s.Add(-other.value(other.negative.offset+int32(i)), c)
+	}
+}
+
+// Append appends the binary representation of the sketch to dst.
+func (s *QuantileSketch) Append(dst []byte) []byte {
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(s.relativeError))
+	dst = binary.AppendUvarint(dst, uint64(s.zeros))
+	dst = s.positive.append(dst)
+	dst = s.negative.append(dst)
+	return dst
+}
+
+// UnmarshalQuantileSketch decodes the sketch appended by Append and returns the rest of src.
+func UnmarshalQuantileSketch(src []byte) (*QuantileSketch, []byte, error) {
+	if len(src) < 8 {
+		return nil, nil, fmt.Errorf("quantile sketch is too short: %d bytes", len(src))
+	}
+	relativeError := math.Float64frombits(binary.LittleEndian.Uint64(src))
+	src = src[8:]
+	if !(relativeError > 0 && relativeError < 1) {
+		return nil, nil, fmt.Errorf("invalid quantile sketch relative error: %f", relativeError)
+	}
+	s := NewQuantileSketch(relativeError)
+
+	zeros, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, nil, fmt.Errorf("malformed quantile sketch zeros: %d", n)
+	}
+	src = src[n:]
+	s.zeros = int64(zeros)
+	s.count = s.zeros
+
+	var err error
+	for _, store := range []*sketchStore{&s.positive, &s.negative} {
+		if src, err = store.unmarshal(src); err != nil {
+			return nil, nil, err
+		}
+		s.count += store.total()
+	}
+	return s, src, nil
+}
+
+// sketchStore contains counts of the consecutive buckets starting from the offset one.
+type sketchStore struct {
+	offset int32
+	counts []int64
+}
+
+func (s *sketchStore) add(index int32, cnt int64) {
+	if len(s.counts) == 0 {
+		s.offset = index
+		s.counts = append(s.counts, 0)
+	}
+
+	if index < s.offset {
+		// Values below the lowest bucket are collapsed into it.
+		last := s.offset + int32(len(s.counts)) - 1
+		index = max(index, last-maxSketchBuckets+1)
+		if grow := int(s.offset - index); grow > 0 {
+			s.counts = append(make([]int64, grow, grow+len(s.counts)), s.counts...)
+			s.offset = index
+		}
+	} else if last := s.offset + int32(len(s.counts)) - 1; index > last {
+		s.counts = append(s.counts, make([]int64, index-last)...)
+		if extra := len(s.counts) - maxSketchBuckets; extra > 0 {
+			collapsed := int64(0)
+			for _, c := range s.counts[:extra] {
+				collapsed += c
+			}
+			s.counts = append(s.counts[:0], s.counts[extra:]...)
+			s.counts[0] += collapsed
+			s.offset += int32(extra)
+		}
+	}
+
+	s.counts[index-s.offset] += cnt
+}
+
+func (s *sketchStore) merge(other *sketchStore) {
+	for i, c := range other.counts {
+		if c != 0 {
+			s.add(other.offset+int32(i), c)
+		}
+	}
+}
+
+func (s *sketchStore) total() int64 {
+	total := int64(0)
+	for _, c := range s.counts {
+		total += c
+	}
+	return total
+}
+
+func (s *sketchStore) append(dst []byte) []byte {
+	dst = binary.AppendVarint(dst, int64(s.offset))
+	dst = binary.AppendUvarint(dst, uint64(len(s.counts)))
+	for _, c := range s.counts {
+		dst = binary.AppendUvarint(dst, uint64(c))
+	}
+	return dst
+}
+
+func (s *sketchStore) unmarshal(src []byte) ([]byte, error) {
+	offset, n := binary.Varint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("malformed quantile sketch offset: %d", n)
+	}
+	src = src[n:]
+
+	length, n := binary.Uvarint(src)
+	if n <= 0 || length > maxSketchBuckets {
+		return nil, fmt.Errorf("malformed quantile sketch length: %d", length)
+	}
+	src = src[n:]
+
+	if length == 0 {
+		return src, nil
+	}
+	s.offset = int32(offset)
+	s.counts = make([]int64, length)
+	for i := range s.counts {
+		c, n := binary.Uvarint(src)
+		if n <= 0 {
+			return nil, fmt.Errorf("malformed quantile sketch count at pos %d", i)
+		}
+		src = src[n:]
+		s.counts[i] = int64(c)
+	}
+	return src, nil
+}
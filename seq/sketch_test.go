@@ -0,0 +1,127 @@
+package seq
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantileSketchRelativeError(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	values := make([]float64, 0, 100_000)
+	for range cap(values) {
+		// Log-normal values spanning several orders of magnitude, some of them are negative or zero.
+		v := math.Exp(rng.NormFloat64() * 3)
+		switch rng.IntN(10) {
+		case 0:
+			v = -v
+		case 1:
+			v = 0
+		}
+		values = append(values, v)
+	}
+
+	for _, relativeError := range []float64{MinQuantileError, DefaultQuantileError, 0.1} {
+		// Values are split between the sketches like between fractions and stores.
+		merged := NewQuantileSketch(relativeError)
+		for part := range slices.Chunk(values, 7_000) {
+			s := NewQuantileSketch(relativeError)
+			for _, v := range part {
+				s.Add(v, 1)
+			}
+			merged.Merge(s)
+		}
+		require.Equal(t, int64(len(values)), merged.Count())
+
+		sorted := slices.Sorted(slices.Values(values))
+		for _, q := range []float64{0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999} {
+			exact := sorted[int(q*float64(len(sorted)-1)+0.5)]
+			assert.InDelta(t, exact, merged.Quantile(q), math.Abs(exact)*relativeError+1e-12, "quantile %v", q)
+		}
+	}
+}
+
+func TestQuantileSketchMerge(t *testing.T) {
+	all := NewQuantileSketch(DefaultQuantileError)
+	a := NewQuantileSketch(DefaultQuantileError)
+	b := NewQuantileSketch(DefaultQuantileError)
+	for i := range 1000 {
+		v := float64(i*i) - 5000
+		all.Add(v, 2)
+		if i%2 == 0 {
+			a.Add(v, 2)
+		} else {
+			b.Add(v, 1)
+			b.Add(v, 1)
+		}
+	}
+	a.Merge(b)
+	assert.Equal(t, all, a)
+
+	// Values of the sketch with another error are added as the values of its buckets.
+	c := NewQuantileSketch(0.05)
+	c.Merge(all)
+	assert.Equal(t, all.Count(), c.Count())
+	assert.InEpsilon(t, all.Quantile(0.9), c.Quantile(0.9), 0.06+DefaultQuantileError)
+}
+
+func TestQuantileSketchMarshal(t *testing.T) {
+	s := NewQuantileSketch(DefaultQuantileError)
+	for _, v := range []float64{-300, -2, 0, 0, 1e-300, 0.5, 100, 1e300} {
+		s.Add(v, 3)
+	}
+
+	data := s.Append([]byte("prefix"))
+	out, tail, err := UnmarshalQuantileSketch(append(data[len("prefix"):], "tail"...))
+	require.NoError(t, err)
+	assert.Equal(t, "tail", string(tail))
+	assert.Equal(t, s, out)
+
+	empty := NewQuantileSketch(0.05)
+	out, _, err = UnmarshalQuantileSketch(empty.Append(nil))
+	require.NoError(t, err)
+	assert.Equal(t, empty, out)
+	assert.True(t, math.IsNaN(out.Quantile(0.5)))
+
+	_, _, err = UnmarshalQuantileSketch(data[len("prefix") : len(data)-2])
+	require.Error(t, err)
+}
+
+func TestQuantileSketchCollapse(t *testing.T) {
+	s := NewQuantileSketch(DefaultQuantileError)
+	s.Add(1e-50, 1)
+	s.Add(1, 1)
+	s.Add(1e50, 1)
+	s.Add(1e-60, 1)
+
+	// The lowest values are collapsed into the lowest kept bucket, the higher ones are accurate.
+	assert.Len(t, s.positive.counts, maxSketchBuckets)
+	assert.Equal(t, int64(4), s.Count())
+	assert.Greater(t, s.Quantile(0), 1e-50)
+	assert.InEpsilon(t, 1, s.Quantile(0.67), DefaultQuantileError)
+	assert.InEpsilon(t, 1e50, s.Quantile(1), DefaultQuantileError)
+}
+
+func TestSamplesContainerQuantile(t *testing.T) {
+	h := NewSamplesContainers()
+	assert.True(t, math.IsNaN(h.Quantile(0.5)))
+
+	for _, v := range []float64{10, 20, 30} {
+		h.InsertNTimes(v, 1)
+		h.InsertSampleNTimes(v, 1, 0.1)
+	}
+	assert.Equal(t, float64(10), h.Quantile(0))
+	assert.Equal(t, float64(30), h.Quantile(1))
+	assert.InEpsilon(t, 20, h.Quantile(0.5), 0.1)
+	// Estimate does not exceed the values range.
+	assert.LessOrEqual(t, h.Quantile(0.99), float64(30))
+
+	other := NewSamplesContainers()
+	other.Merge(h)
+	assert.Equal(t, h.Sketch, other.Sketch)
+	assert.NotSame(t, h.Sketch, other.Sketch)
+}
@@ -2,6 +2,7 @@ package seq
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ozontech/seq-db/util"
 )
@@ -28,6 +29,11 @@ const (
 	TokenizerTypePath    TokenizerType = 6
 	TokenizerTypeNested  TokenizerType = 7
 	TokenizerTypeExists  TokenizerType = 8
+	TokenizerTypeLong    TokenizerType = 9
+	TokenizerTypeDouble  TokenizerType = 10
+	TokenizerTypeIP      TokenizerType = 11
+	TokenizerTypeDate    TokenizerType = 12
+	TokenizerTypeNgram   TokenizerType = 13
 )
 
 var TokenTypesToNames = map[TokenizerType]string{
@@ -39,6 +45,11 @@ var TokenTypesToNames = map[TokenizerType]string{
 	TokenizerTypePath:    "path",
 	TokenizerTypeNested:  "nested",
 	TokenizerTypeExists:  "exists",
+	TokenizerTypeLong:    "long",
+	TokenizerTypeDouble:  "double",
+	TokenizerTypeIP:      "ip",
+	TokenizerTypeDate:    "date",
+	TokenizerTypeNgram:   "ngram",
 }
 
 var NamesToTokenTypes = map[string]TokenizerType{}
@@ -49,6 +60,34 @@ func init() {
 	}
 }
 
+// IsStringType returns true for tokenizer types which tokens are parts of the string values,
+// so they can be indexed either as is or in lower case.
+func IsStringType(t TokenizerType) bool {
+	switch t {
+	case TokenizerTypeKeyword, TokenizerTypeText, TokenizerTypePath, TokenizerTypeNgram:
+		return true
+	}
+	return false
+}
+
+// FormatToken returns human-readable representation of token of the given type.
+// Binary tokens of numeric, date and ip fields are decoded, other tokens are returned as is.
+func FormatToken(t TokenizerType, b []byte) string {
+	switch t {
+	case TokenizerTypeIP:
+		if addr, ok := DecodeIP(b); ok {
+			return addr.String()
+		}
+	case TokenizerTypeDate:
+		if date, ok := DecodeDate(b); ok {
+			return date.Format(time.RFC3339Nano)
+		}
+	default:
+		return FormatNumeric(t, b)
+	}
+	return string(b)
+}
+
 type Token struct {
 	Field []byte
 	Val   []byte
@@ -19,10 +19,15 @@ func (g *GrpcV1) StartAsyncSearch(
 	_ context.Context,
 	r *storeapi.StartAsyncSearchRequest,
 ) (*storeapi.StartAsyncSearchResponse, error) {
-	aggs, err := aggQueriesFromProto(r.Aggs)
+	aggs, err := aggQueriesFromProto(r.Aggs, g.mappingProvider.GetMapping())
 	if err != nil {
 		return nil, err
 	}
+	for _, agg := range aggs {
+		if len(agg.Filters) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "filters are not supported by async search")
+		}
+	}
 
 	limit := 0
 	if r.WithDocs {
@@ -133,8 +138,12 @@ func convertAggQueriesToProto(query []processor.AggQuery) []*storeapi.AggQuery {
 	var res []*storeapi.AggQuery
 	for _, q := range query {
 		pq := &storeapi.AggQuery{
-			Func:      storeapi.MustProtoAggFunc(q.Func),
-			Quantiles: q.Quantiles,
+			Func:          storeapi.MustProtoAggFunc(q.Func),
+			Quantiles:     q.Quantiles,
+			QuantileError: q.QuantileError,
+			Ranges:        q.Buckets.Ranges,
+			BinWidth:      q.Buckets.BinWidth,
+			BinLogBase:    q.Buckets.BinLogBase,
 		}
 		if q.Field != nil {
 			pq.Field = q.Field.Field
@@ -142,6 +151,12 @@ func convertAggQueriesToProto(query []processor.AggQuery) []*storeapi.AggQuery {
 		if q.GroupBy != nil {
 			pq.GroupBy = q.GroupBy.Field
 		}
+		for _, thenBy := range q.ThenBy {
+			pq.ThenBy = append(pq.ThenBy, thenBy.Field)
+		}
+		if q.IntervalField != nil {
+			pq.IntervalField = q.IntervalField.Field
+		}
 		res = append(res, pq)
 	}
 	return res
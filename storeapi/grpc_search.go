@@ -147,13 +147,16 @@ func (g *GrpcV1) doSearch(
 		return nil, fmt.Errorf("search cancelled before evaluating: reason=%w", ctx.Err())
 	}
 
-	aggQ, err := aggQueriesFromProto(req.Aggs)
+	aggQ, err := aggQueriesFromProto(req.Aggs, g.mappingProvider.GetMapping())
 	if err != nil {
 		return nil, err
 	}
+	sortQ := sortQueriesFromProto(req.Sort, g.mappingProvider.GetMapping())
 
 	const millisecondsInSecond = float64(time.Second / time.Millisecond)
-	metric.SearchRangesSeconds.Observe(float64(to-from) / millisecondsInSecond)
+	if to >= from {
+		metric.SearchRangesSeconds.Observe(float64(to-from) / millisecondsInSecond)
+	}
 
 	searchParams := processor.SearchParams{
 		AST:          ast,
@@ -164,6 +167,7 @@ func (g *GrpcV1) doSearch(
 		Limit:        limit,
 		WithTotal:    req.WithTotal,
 		Order:        req.Order.MustDocsOrder(),
+		Sort:         sortQ,
 	}
 
 	searchTr := tr.NewChild("search iteratively")
@@ -229,6 +233,9 @@ func (g *GrpcV1) parseQuery(ctx context.Context, query string) (*parser.ASTNode,
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "can't parse query %q: %v", query, err)
 		}
+		if len(seqql.Subqueries) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "subqueries of query %q must be resolved by seq-proxy", query)
+		}
 		ast = seqql.Root
 	} else {
 		var err error
@@ -266,6 +273,7 @@ func buildSearchResponse(qpr *seq.QPR) *storeapi.SearchResponse {
 			Rid: uint64(qpr.IDs[i].ID.RID),
 		}
 		idSourcesBuf[i].Hint = qpr.IDs[i].Hint
+		idSourcesBuf[i].SortValues = sortValuesToProto(qpr.IDs[i].SortValues)
 
 		idSources[i] = &idSourcesBuf[i]
 	}
@@ -296,9 +304,14 @@ func buildSearchResponse(qpr *seq.QPR) *storeapi.SearchResponse {
 				Max:       hist.Max,
 				Sum:       hist.Sum,
 				Total:     hist.Total,
-				Samples:   hist.Samples,
 				NotExists: hist.NotExists,
 			}
+			if hist.Sketch != nil {
+				pbhist.Sketch = hist.Sketch.Append(nil)
+			}
+			if hist.Cardinality != nil {
+				pbhist.Cardinality = hist.Cardinality.Append(nil)
+			}
 
 			curAgg.Timeseries = append(curAgg.Timeseries,
 				&storeapi.SearchResponse_Bin{
@@ -327,10 +340,10 @@ func buildSearchResponse(qpr *seq.QPR) *storeapi.SearchResponse {
 	}
 }
 
-func aggQueriesFromProto(aggs []*storeapi.AggQuery) ([]processor.AggQuery, error) {
+func aggQueriesFromProto(aggs []*storeapi.AggQuery, mapping *seq.Mapping) ([]processor.AggQuery, error) {
 	aggQ := make([]processor.AggQuery, 0, len(aggs))
 	for _, aggQuery := range aggs {
-		aggFunc, err := aggQueryFromProto(aggQuery)
+		aggFunc, err := aggQueryFromProto(aggQuery, mapping)
 		if err != nil {
 			return nil, err
 		}
@@ -339,9 +352,15 @@ func aggQueriesFromProto(aggs []*storeapi.AggQuery) ([]processor.AggQuery, error
 	return aggQ, nil
 }
 
-func aggQueryFromProto(aggQuery *storeapi.AggQuery) (processor.AggQuery, error) {
+func aggQueryFromProto(aggQuery *storeapi.AggQuery, mapping *seq.Mapping) (processor.AggQuery, error) {
+	filters, err := aggFiltersFromProto(aggQuery, mapping)
+	if err != nil {
+		return processor.AggQuery{}, err
+	}
+
 	// 'groupBy' is required for Count and Unique.
-	if aggQuery.GroupBy == "" && (aggQuery.Func == storeapi.AggFunc_AGG_FUNC_COUNT || aggQuery.Func == storeapi.AggFunc_AGG_FUNC_UNIQUE) {
+	if aggQuery.GroupBy == "" && len(filters) == 0 &&
+		(aggQuery.Func == storeapi.AggFunc_AGG_FUNC_COUNT || aggQuery.Func == storeapi.AggFunc_AGG_FUNC_UNIQUE) {
 		return processor.AggQuery{}, fmt.Errorf("%w: groupBy is required for %s func", consts.ErrInvalidAggQuery, aggQuery.Func)
 	}
 
@@ -355,6 +374,11 @@ func aggQueryFromProto(aggQuery *storeapi.AggQuery) (processor.AggQuery, error)
 		return processor.AggQuery{}, fmt.Errorf("%w: expect an argument for Quantile func", consts.ErrInvalidAggQuery)
 	}
 
+	if e := aggQuery.QuantileError; e != 0 && (e < seq.MinQuantileError || e > seq.MaxQuantileError) {
+		return processor.AggQuery{}, fmt.Errorf("%w: quantileError must be in [%v, %v] range, got %v",
+			consts.ErrInvalidAggQuery, seq.MinQuantileError, seq.MaxQuantileError, e)
+	}
+
 	var field *parser.Literal
 	if aggQuery.Field != "" {
 		field = &parser.Literal{
@@ -371,20 +395,153 @@ func aggQueryFromProto(aggQuery *storeapi.AggQuery) (processor.AggQuery, error)
 		}
 	}
 
+	if len(aggQuery.ThenBy) > 0 && aggQuery.GroupBy == "" {
+		return processor.AggQuery{}, fmt.Errorf("%w: groupBy is required for thenBy", consts.ErrInvalidAggQuery)
+	}
+
+	buckets := seq.NumericBuckets{
+		Ranges:     aggQuery.Ranges,
+		BinWidth:   aggQuery.BinWidth,
+		BinLogBase: aggQuery.BinLogBase,
+	}
+	if buckets.IsSet() && aggQuery.GroupBy == "" {
+		return processor.AggQuery{}, fmt.Errorf("%w: groupBy is required for buckets", consts.ErrInvalidAggQuery)
+	}
+	if err := buckets.Validate(); err != nil {
+		return processor.AggQuery{}, fmt.Errorf("%w: %s", consts.ErrInvalidAggQuery, err)
+	}
+	if len(aggQuery.ThenBy)+1 > seq.MaxGroupByFields {
+		return processor.AggQuery{}, fmt.Errorf("%w: grouping by more than %d fields is not supported",
+			consts.ErrInvalidAggQuery, seq.MaxGroupByFields)
+	}
+
+	var thenBy []*parser.Literal
+	var thenByTypes []seq.TokenizerType
+	for _, f := range aggQuery.ThenBy {
+		thenBy = append(thenBy, &parser.Literal{
+			Field: f,
+			Terms: searchAll,
+		})
+		thenByTypes = append(thenByTypes, aggFieldType(mapping, f))
+	}
+
+	var intervalField *parser.Literal
+	if aggQuery.IntervalField != "" {
+		if aggQuery.Interval <= 0 {
+			return processor.AggQuery{}, fmt.Errorf("%w: interval is required for intervalField", consts.ErrInvalidAggQuery)
+		}
+		if t := aggFieldType(mapping, aggQuery.IntervalField); t != seq.TokenizerTypeDate {
+			return processor.AggQuery{}, fmt.Errorf("%w: intervalField %q must be of date type, got %s",
+				consts.ErrInvalidAggQuery, aggQuery.IntervalField, seq.TokenTypesToNames[t])
+		}
+		intervalField = &parser.Literal{
+			Field: aggQuery.IntervalField,
+			Terms: searchAll,
+		}
+	}
+
 	aggFunc, err := aggQuery.Func.ToAggFunc()
 	if err != nil {
 		return processor.AggQuery{}, err
 	}
 
 	return processor.AggQuery{
-		Field:     field,
-		GroupBy:   groupBy,
-		Func:      aggFunc,
-		Interval:  aggQuery.Interval,
-		Quantiles: aggQuery.Quantiles,
+		Field:         field,
+		GroupBy:       groupBy,
+		Func:          aggFunc,
+		Interval:      aggQuery.Interval,
+		IntervalField: intervalField,
+		Quantiles:     aggQuery.Quantiles,
+		QuantileError: aggQuery.QuantileError,
+		FieldType:     aggFieldType(mapping, aggQuery.Field),
+		GroupByType:   aggFieldType(mapping, aggQuery.GroupBy),
+		ThenBy:        thenBy,
+		ThenByTypes:   thenByTypes,
+		Buckets:       buckets,
+		Filters:       filters,
 	}, nil
 }
 
+// aggFiltersFromProto parses the seq-ql queries of the filters aggregation.
+func aggFiltersFromProto(aggQuery *storeapi.AggQuery, mapping *seq.Mapping) ([]processor.AggFilter, error) {
+	if len(aggQuery.Filters) == 0 {
+		return nil, nil
+	}
+	if aggQuery.Field != "" || aggQuery.GroupBy != "" || len(aggQuery.ThenBy) > 0 {
+		return nil, fmt.Errorf("%w: filters can't be used with field, groupBy and thenBy", consts.ErrInvalidAggQuery)
+	}
+	if aggQuery.Func != storeapi.AggFunc_AGG_FUNC_COUNT {
+		return nil, fmt.Errorf("%w: filters support only %s func", consts.ErrInvalidAggQuery, storeapi.AggFunc_AGG_FUNC_COUNT)
+	}
+	if len(aggQuery.Filters) > seq.MaxAggFilters {
+		return nil, fmt.Errorf("%w: more than %d filters are not supported", consts.ErrInvalidAggQuery, seq.MaxAggFilters)
+	}
+
+	filters := make([]processor.AggFilter, 0, len(aggQuery.Filters))
+	names := make(map[string]struct{}, len(aggQuery.Filters))
+	for _, f := range aggQuery.Filters {
+		if f.Name == "" {
+			return nil, fmt.Errorf("%w: filter name is required", consts.ErrInvalidAggQuery)
+		}
+		if _, ok := names[f.Name]; ok {
+			return nil, fmt.Errorf("%w: filter name %q is repeated", consts.ErrInvalidAggQuery, f.Name)
+		}
+		names[f.Name] = struct{}{}
+
+		q, err := parser.ParseSeqQL(f.Query, mapping)
+		if err != nil {
+			return nil, fmt.Errorf("%w: can't parse filter %q: %s", consts.ErrInvalidAggQuery, f.Name, err)
+		}
+		if len(q.Pipes) > 0 || len(q.Subqueries) > 0 || q.Time != nil {
+			return nil, fmt.Errorf("%w: filter %q can't contain pipes, subqueries and '_time' filters",
+				consts.ErrInvalidAggQuery, f.Name)
+		}
+		filters = append(filters, processor.AggFilter{Name: f.Name, AST: q.Root})
+	}
+	return filters, nil
+}
+
+func sortQueriesFromProto(sort []*storeapi.SearchRequest_SortField, mapping *seq.Mapping) []processor.SortQuery {
+	if len(sort) == 0 {
+		return nil
+	}
+	sortQ := make([]processor.SortQuery, 0, len(sort))
+	for _, s := range sort {
+		sortQ = append(sortQ, processor.SortQuery{
+			Field: &parser.Literal{
+				Field: s.Field,
+				Terms: searchAll,
+			},
+			FieldType: aggFieldType(mapping, s.Field),
+			Desc:      s.Desc,
+		})
+	}
+	return sortQ
+}
+
+func sortValuesToProto(values []seq.SortValue) []*storeapi.SearchResponse_SortValue {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]*storeapi.SearchResponse_SortValue, len(values))
+	for i, v := range values {
+		res[i] = &storeapi.SearchResponse_SortValue{
+			Value:   []byte(v.Value),
+			Missing: v.Missing,
+		}
+	}
+	return res
+}
+
+// aggFieldType returns index type of aggregated field. Only numeric fields need special handling,
+// so fields without mapping are considered keywords.
+func aggFieldType(mapping *seq.Mapping, field string) seq.TokenizerType {
+	if types, ok := mapping.Lookup(field); ok {
+		return types.Main.TokenizerType
+	}
+	return seq.TokenizerTypeKeyword
+}
+
 var searchAll = []parser.Term{{
 	Kind: parser.TermSymbol, Data: aggAsteriskFilter,
 }}
@@ -396,6 +553,9 @@ func (s *aggQueryMarshaler) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("groupBy", s.GroupBy)
 	enc.AddString("func", s.Func.String())
 	enc.AddInt("quantiles_count", len(s.Quantiles))
+	if s.IntervalField != "" {
+		enc.AddString("interval_field", s.IntervalField)
+	}
 	return nil
 }
 
@@ -0,0 +1,178 @@
+package storeapi
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/frac/processor"
+	"github.com/ozontech/seq-db/pkg/storeapi"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestAggQueryIntervalField(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"started_at": seq.NewSingleType(seq.TokenizerTypeDate, "", 0),
+	}}
+	newQuery := func(intervalField string, interval int64) *storeapi.AggQuery {
+		return &storeapi.AggQuery{
+			GroupBy:       "service",
+			Func:          storeapi.AggFunc_AGG_FUNC_COUNT,
+			Interval:      interval,
+			IntervalField: intervalField,
+		}
+	}
+
+	q, err := aggQueryFromProto(newQuery("started_at", 60_000), mapping)
+	require.NoError(t, err)
+	require.NotNil(t, q.IntervalField)
+	assert.Equal(t, "started_at", q.IntervalField.Field)
+	assert.Equal(t, int64(60_000), q.Interval)
+
+	q, err = aggQueryFromProto(newQuery("", 60_000), mapping)
+	require.NoError(t, err)
+	assert.Nil(t, q.IntervalField)
+
+	_, err = aggQueryFromProto(newQuery("started_at", 0), mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "interval is required")
+
+	_, err = aggQueryFromProto(newQuery("service", 60_000), mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "must be of date type")
+
+	_, err = aggQueryFromProto(newQuery("unknown", 60_000), mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+}
+
+func TestAggQueryThenBy(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"status":  seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+	}}
+	newQuery := func(groupBy string, thenBy ...string) *storeapi.AggQuery {
+		return &storeapi.AggQuery{
+			GroupBy: groupBy,
+			ThenBy:  thenBy,
+			Func:    storeapi.AggFunc_AGG_FUNC_COUNT,
+		}
+	}
+
+	q, err := aggQueryFromProto(newQuery("service", "status"), mapping)
+	require.NoError(t, err)
+	require.Len(t, q.ThenBy, 1)
+	assert.Equal(t, "status", q.ThenBy[0].Field)
+	assert.Equal(t, []seq.TokenizerType{seq.TokenizerTypeLong}, q.ThenByTypes)
+	assert.Equal(t, []*storeapi.AggQuery{newQuery("service", "status")}, convertAggQueriesToProto([]processor.AggQuery{q}))
+
+	_, err = aggQueryFromProto(&storeapi.AggQuery{
+		Field:  "status",
+		ThenBy: []string{"service"},
+		Func:   storeapi.AggFunc_AGG_FUNC_SUM,
+	}, mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "groupBy is required for thenBy")
+
+	_, err = aggQueryFromProto(newQuery("service", "a", "b", "c", "d"), mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "grouping by more than 4 fields")
+}
+
+func TestAggQueryQuantileError(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{"duration": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0)}}
+	newQuery := func(quantileError float64) *storeapi.AggQuery {
+		return &storeapi.AggQuery{
+			Field:         "duration",
+			Func:          storeapi.AggFunc_AGG_FUNC_QUANTILE,
+			Quantiles:     []float64{0.99},
+			QuantileError: quantileError,
+		}
+	}
+
+	q, err := aggQueryFromProto(newQuery(0.05), mapping)
+	require.NoError(t, err)
+	assert.Equal(t, 0.05, q.QuantileError)
+	assert.Equal(t, []*storeapi.AggQuery{newQuery(0.05)}, convertAggQueriesToProto([]processor.AggQuery{q}))
+
+	q, err = aggQueryFromProto(newQuery(0), mapping)
+	require.NoError(t, err)
+	assert.Zero(t, q.QuantileError)
+
+	for _, e := range []float64{-1, 0.001, 0.9} {
+		_, err = aggQueryFromProto(newQuery(e), mapping)
+		require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+		assert.Contains(t, err.Error(), "quantileError must be in")
+	}
+}
+
+func TestAggQueryBuckets(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{"duration": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0)}}
+	query := &storeapi.AggQuery{
+		GroupBy: "duration",
+		Func:    storeapi.AggFunc_AGG_FUNC_COUNT,
+		Ranges:  []float64{100, 500},
+	}
+
+	q, err := aggQueryFromProto(query, mapping)
+	require.NoError(t, err)
+	assert.Equal(t, seq.NumericBuckets{Ranges: []float64{100, 500}}, q.Buckets)
+	assert.Equal(t, []*storeapi.AggQuery{query}, convertAggQueriesToProto([]processor.AggQuery{q}))
+
+	_, err = aggQueryFromProto(&storeapi.AggQuery{Field: "duration", Func: storeapi.AggFunc_AGG_FUNC_SUM, BinWidth: 10}, mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "groupBy is required for buckets")
+
+	_, err = aggQueryFromProto(&storeapi.AggQuery{GroupBy: "duration", Func: storeapi.AggFunc_AGG_FUNC_COUNT, BinLogBase: 0.5}, mapping)
+	require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+	assert.Contains(t, err.Error(), "bin log base must be greater than 1")
+}
+
+func TestAggQueryFilters(t *testing.T) {
+	mapping := &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"level":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+	}}
+	newQuery := func(filters ...*storeapi.AggQuery_Filter) *storeapi.AggQuery {
+		return &storeapi.AggQuery{Func: storeapi.AggFunc_AGG_FUNC_COUNT, Interval: 1000, Filters: filters}
+	}
+
+	q, err := aggQueryFromProto(newQuery(
+		&storeapi.AggQuery_Filter{Name: "errors", Query: "level:error"},
+		&storeapi.AggQuery_Filter{Name: "timeouts", Query: "message:timeout and not level:debug"},
+	), mapping)
+	require.NoError(t, err)
+	require.Len(t, q.Filters, 2)
+	assert.Equal(t, "errors", q.Filters[0].Name)
+	assert.Equal(t, "level:error", q.Filters[0].AST.SeqQLString())
+	assert.Equal(t, "timeouts", q.Filters[1].Name)
+	assert.Equal(t, int64(1000), q.Interval)
+
+	test := func(query *storeapi.AggQuery, expected string) {
+		t.Helper()
+		_, err := aggQueryFromProto(query, mapping)
+		require.ErrorIs(t, err, consts.ErrInvalidAggQuery)
+		assert.Contains(t, err.Error(), expected)
+	}
+	levelError := &storeapi.AggQuery_Filter{Name: "errors", Query: "level:error"}
+	test(newQuery(levelError, levelError), `filter name "errors" is repeated`)
+	test(newQuery(&storeapi.AggQuery_Filter{Query: "level:error"}), "filter name is required")
+	test(newQuery(&storeapi.AggQuery_Filter{Name: "bad", Query: "level:("}), `can't parse filter "bad"`)
+	test(newQuery(&storeapi.AggQuery_Filter{Name: "pipe", Query: "level:error | fields level"}), "can't contain pipes")
+	test(newQuery(&storeapi.AggQuery_Filter{Name: "time", Query: "_time:>now-1h"}), "'_time' filters")
+	test(&storeapi.AggQuery{GroupBy: "level", Func: storeapi.AggFunc_AGG_FUNC_COUNT, Filters: []*storeapi.AggQuery_Filter{levelError}},
+		"filters can't be used with field, groupBy and thenBy")
+	test(&storeapi.AggQuery{Field: "level", Func: storeapi.AggFunc_AGG_FUNC_UNIQUE, Filters: []*storeapi.AggQuery_Filter{levelError}},
+		"filters can't be used")
+	test(&storeapi.AggQuery{Func: storeapi.AggFunc_AGG_FUNC_SUM, Filters: []*storeapi.AggQuery_Filter{levelError}},
+		"filters support only AGG_FUNC_COUNT func")
+
+	many := make([]*storeapi.AggQuery_Filter, seq.MaxAggFilters+1)
+	for i := range many {
+		many[i] = &storeapi.AggQuery_Filter{Name: strconv.Itoa(i), Query: "level:error"}
+	}
+	test(newQuery(many...), "more than 100 filters")
+}
@@ -22,7 +22,7 @@ import (
 )
 
 type MappingProvider interface {
-	GetMapping() seq.Mapping
+	GetMapping() *seq.Mapping
 }
 
 type SearchConfig struct {
@@ -51,6 +51,9 @@ func NewStore(ctx context.Context, c StoreConfig, s3cli *s3.Client, mappingProvi
 		return nil, err
 	}
 
+	if c.FracManager.Mapping == nil {
+		c.FracManager.Mapping = mappingProvider
+	}
 	fracManager := fracmanager.NewFracManager(ctx, &c.FracManager, s3cli)
 	err := fracManager.Load(ctx)
 	if err != nil {
@@ -118,10 +118,10 @@ func (s *IntegrationTestSuite) TestSearchOne() {
 
 func (s *IntegrationTestSuite) TestPipeFields() {
 	config := *s.Config
-	config.Mapping = map[string]seq.MappingTypes{
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"event":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"message": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-	}
+	}}
 
 	env := setup.NewTestingEnv(&config)
 	defer env.StopAll()
@@ -198,6 +198,484 @@ func (s *IntegrationTestSuite) TestPipeFields() {
 	]`))
 }
 
+func (s *IntegrationTestSuite) TestPipeStats() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service":  seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"level":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"duration": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"service":"api","level":"error","duration":10}`,
+		`{"service":"api","level":"info","duration":20}`,
+		`{"service":"api","level":"error","duration":30}`,
+		`{"service":"db","level":"error","duration":100}`,
+	}
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), docs)
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) *seqproxyapi.SearchResponse {
+		return setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			WithTotal: true,
+		})
+	}
+	buckets := func(agg *seqproxyapi.Aggregation) map[string]float64 {
+		res := map[string]float64{}
+		for _, b := range agg.Buckets {
+			res[b.Key] = b.Value
+		}
+		return res
+	}
+
+	resp := search(`* | stats count()`)
+	r.Empty(resp.Docs)
+	r.Equal(int64(len(docs)), resp.Total)
+	r.Len(resp.Aggs, 1)
+	r.Equal(map[string]float64{"": 4}, buckets(resp.Aggs[0]))
+
+	resp = search(`level:error | stats count(), max(duration), p50(duration) by service`)
+	r.Empty(resp.Docs)
+	r.Len(resp.Aggs, 3)
+	r.Equal(map[string]float64{"api": 2, "db": 1}, buckets(resp.Aggs[0]))
+	r.Equal(map[string]float64{"api": 30, "db": 100}, buckets(resp.Aggs[1]))
+	r.Equal(map[string]float64{"api": 30, "db": 100}, buckets(resp.Aggs[2]))
+
+	resp = search(`* | stats count(), sum(duration) by service, level`)
+	r.Len(resp.Aggs, 2)
+	r.Equal(map[string]float64{"api, error": 2, "api, info": 1, "db, error": 1}, buckets(resp.Aggs[0]))
+	r.Equal(map[string]float64{"api, error": 40, "api, info": 20, "db, error": 100}, buckets(resp.Aggs[1]))
+	for _, b := range resp.Aggs[0].Buckets {
+		r.Len(b.Keys, 2)
+		r.Equal(b.Key, strings.Join(b.Keys, ", "))
+	}
+
+	// 'stats' pipe after the other pipes is calculated by seq-proxy the same way.
+	resp = search(`* | where duration > 15 | stats count() by service, level`)
+	r.Equal(map[string]float64{"api, error": 1, "api, info": 1, "db, error": 1}, buckets(resp.Aggs[0]))
+}
+
+func (s *IntegrationTestSuite) TestPipeTopRare() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"code":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"api","code":"500"}`,
+		`{"service":"api","code":"500"}`,
+		`{"service":"api","code":"502"}`,
+		`{"service":"api","code":"504"}`,
+		`{"service":"db","code":"500"}`,
+		`{"service":"db","code":"502"}`,
+		`{"service":"auth","code":"500"}`,
+		`{"service":"auth"}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) *seqproxyapi.SearchResponse {
+		return setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			WithTotal: true,
+		})
+	}
+	type bucket struct {
+		Key     string
+		Value   float64
+		Percent float64
+	}
+	buckets := func(agg *seqproxyapi.Aggregation) []bucket {
+		res := make([]bucket, 0, len(agg.Buckets))
+		for _, b := range agg.Buckets {
+			res = append(res, bucket{Key: b.Key, Value: b.Value, Percent: math.Round(b.Percent*100) / 100})
+		}
+		return res
+	}
+
+	resp := search(`* | top 2 service`)
+	r.Empty(resp.Docs)
+	r.Equal(int64(8), resp.Total)
+	r.Len(resp.Aggs, 1)
+	r.Equal([]bucket{
+		{Key: "api", Value: 4, Percent: 50},
+		{Key: "auth", Value: 2, Percent: 25},
+		{Key: "_other", Value: 2, Percent: 25},
+	}, buckets(resp.Aggs[0]))
+
+	resp = search(`* | rare 1 code`)
+	r.Equal([]bucket{
+		{Key: "504", Value: 1, Percent: 14.29},
+		{Key: "_other", Value: 6, Percent: 85.71},
+	}, buckets(resp.Aggs[0]))
+	r.Equal(int64(1), resp.Aggs[0].NotExists)
+
+	// 'top' pipe following the other pipes is calculated by seq-proxy on the fetched documents.
+	resp = search(`* | where service != "api" | top code`)
+	r.Equal([]bucket{
+		{Key: "500", Value: 2, Percent: 66.67},
+		{Key: "502", Value: 1, Percent: 33.33},
+	}, buckets(resp.Aggs[0]))
+	r.Equal(int64(1), resp.Aggs[0].NotExists)
+}
+
+func (s *IntegrationTestSuite) TestPipeTimechart() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"level":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"duration": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	start := time.Now().Truncate(time.Minute).Add(-10 * time.Minute)
+	doc := func(level string, duration int, offset time.Duration) string {
+		return fmt.Sprintf(`{"level":%q,"duration":%d,"time":%q}`, level, duration, start.Add(offset).Format(time.RFC3339Nano))
+	}
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		doc("error", 10, 0),
+		doc("info", 20, 10*time.Second),
+		doc("error", 30, 50*time.Second),
+		doc("info", 40, 2*time.Minute),
+		doc("info", 60, 2*time.Minute+time.Second),
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) *seqproxyapi.SearchResponse {
+		return setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+		})
+	}
+	series := func(agg *seqproxyapi.Aggregation) map[string][]float64 {
+		res := map[string][]float64{}
+		for _, s := range agg.Series {
+			res[s.Key] = s.Values
+		}
+		return res
+	}
+	timestamps := []*timestamppb.Timestamp{timestamppb.New(start), timestamppb.New(start.Add(2 * time.Minute))}
+
+	resp := search(`* | timechart span=1m count() by level`)
+	r.Empty(resp.Docs)
+	r.Len(resp.Aggs, 1)
+	r.Empty(resp.Aggs[0].Buckets)
+	r.Equal(timestamps, resp.Aggs[0].Timestamps)
+	r.Equal(map[string][]float64{"error": {2, 0}, "info": {1, 2}}, series(resp.Aggs[0]))
+
+	resp = search(`level:info | timechart span=1m count(), max(duration)`)
+	r.Len(resp.Aggs, 2)
+	r.Equal(map[string][]float64{"": {1, 2}}, series(resp.Aggs[0]))
+	r.Equal(map[string][]float64{"": {20, 60}}, series(resp.Aggs[1]))
+
+	// 'timechart' pipe following the other pipes is calculated by seq-proxy on the fetched documents.
+	resp = search(`* | where duration >= 30 | timechart span=1m count() by level`)
+	r.Equal(timestamps, resp.Aggs[0].Timestamps)
+	r.Equal(map[string][]float64{"error": {1, 0}, "info": {0, 2}}, series(resp.Aggs[0]))
+}
+
+func (s *IntegrationTestSuite) TestPipeSort() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"status":  seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"id":1,"service":"b","status":200}`,
+		`{"id":2,"service":"a","status":500}`,
+		`{"id":3,"service":"c","status":-5}`,
+		`{"id":4,"service":"a","status":30}`,
+		`{"id":5,"service":"b"}`,
+	}
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), docs)
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string, size int64, sort ...*seqproxyapi.SortField) []string {
+		resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			Size:      size,
+			WithTotal: true,
+			Sort:      sort,
+		})
+		r.Equal(int64(len(docs)), resp.Total)
+		ids := make([]string, 0, len(resp.Docs))
+		for _, doc := range resp.Docs {
+			var d struct{ ID json.Number }
+			r.NoError(json.Unmarshal(doc.Data, &d))
+			ids = append(ids, d.ID.String())
+		}
+		return ids
+	}
+
+	r.Equal([]string{"3", "4", "1", "2", "5"}, search(`* | sort by status`, 10))
+	r.Equal([]string{"2", "1", "4", "3", "5"}, search(`* | sort by status desc`, 10))
+	r.Equal([]string{"2", "1"}, search(`service:* | sort by status desc`, 2))
+	r.Equal([]string{"2", "4", "1"}, search(`* | sort by service, status desc | where status > 0`, 3))
+	r.Equal([]string{"3", "1", "5", "4", "2"}, search(`*`, 10, &seqproxyapi.SortField{Field: "service", Desc: true}, &seqproxyapi.SortField{Field: "status"}))
+}
+
+func (s *IntegrationTestSuite) TestPipeHeadTailDedup() {
+	env := setup.NewTestingEnv(s.Config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"id":1,"trace_id":"a","level":"error"}`,
+		`{"id":2,"trace_id":"b","level":"info"}`,
+		`{"id":3,"trace_id":"a","level":"error"}`,
+		`{"id":4,"trace_id":"c","level":"error"}`,
+		`{"id":5,"trace_id":"b","level":"error"}`,
+	}
+	// Documents are bulked one by one to have different timestamps.
+	for _, doc := range docs {
+		setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{doc})
+		time.Sleep(time.Millisecond)
+	}
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) []string {
+		resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			Size:  10,
+			Order: seqproxyapi.Order_ORDER_ASC,
+		})
+		ids := make([]string, 0, len(resp.Docs))
+		for _, doc := range resp.Docs {
+			var d struct{ ID json.Number }
+			r.NoError(json.Unmarshal(doc.Data, &d))
+			ids = append(ids, d.ID.String())
+		}
+		return ids
+	}
+
+	r.Equal([]string{"1", "2"}, search(`* | head 2`))
+	r.Equal([]string{"4", "5"}, search(`* | tail 2`))
+	r.Equal([]string{"1", "2", "4"}, search(`* | dedup trace_id`))
+	r.Equal([]string{"1", "4", "5"}, search(`level:error | dedup trace_id | limit 3`))
+	r.Equal([]string{"4"}, search(`* | where level = "error" | tail 2 | head 1`))
+}
+
+func (s *IntegrationTestSuite) TestPipeRenameEval() {
+	env := setup.NewTestingEnv(s.Config)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"api","message":"user=bob","latency_ms":1500}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) string {
+		resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			Size: 10,
+		})
+		r.Len(resp.Docs, 1)
+		return string(resp.Docs[0].Data)
+	}
+
+	r.JSONEq(
+		`{"latency_s":1.5,"is_slow":true}`,
+		search(`service:api | eval latency_s = latency_ms / 1000, is_slow = latency_ms > 500 | fields latency_s, is_slow`),
+	)
+	r.JSONEq(
+		`{"svc":"API","msg":"user=bob"}`,
+		search(`* | rename service as svc, message as msg | eval svc = upper(svc) | fields svc, msg`),
+	)
+	r.JSONEq(
+		`{"service":"api","tag":"api:slow"}`,
+		search(`* | eval tag = concat(service, ":", if(latency_ms > 1000, "slow", "fast")) | where tag = "api:slow" | fields service, tag`),
+	)
+}
+
+func (s *IntegrationTestSuite) TestPipeExtract() {
+	env := setup.NewTestingEnv(s.Config)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"api","message":"user=bob took 15ms"}`,
+		`{"service":"api","message":"user=alice took 700ms"}`,
+		`{"service":"api","message":"user=bob took 900ms"}`,
+		`{"service":"api","message":"health check"}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) *seqproxyapi.SearchResponse {
+		return setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			Size:      10,
+			WithTotal: true,
+		})
+	}
+
+	resp := search(`service:api | extract "user=<user> took <dur>ms" | where dur > 100 | fields user, dur`)
+	found := make([]map[string]string, 0, len(resp.Docs))
+	for _, doc := range resp.Docs {
+		var d map[string]string
+		r.NoError(json.Unmarshal(doc.Data, &d))
+		found = append(found, d)
+	}
+	r.ElementsMatch([]map[string]string{{"user": "alice", "dur": "700"}, {"user": "bob", "dur": "900"}}, found)
+
+	resp = search(`* | extract re("user=(?P<user>\\w+) took (?P<dur>\\d+)ms") | stats count(), max(dur) by user`)
+	r.Empty(resp.Docs)
+	r.Equal(int64(4), resp.Total)
+	r.Len(resp.Aggs, 2)
+	buckets := map[string]float64{}
+	for _, b := range resp.Aggs[0].Buckets {
+		buckets[b.Key] = b.Value
+	}
+	r.Equal(map[string]float64{"bob": 2, "alice": 1, "_not_exists": 1}, buckets)
+	r.Equal(int64(1), resp.Aggs[0].NotExists)
+	r.Equal("bob", resp.Aggs[1].Buckets[0].Key)
+	r.Equal(900.0, resp.Aggs[1].Buckets[0].Value)
+}
+
+func (s *IntegrationTestSuite) TestSearchSubquery() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"trace_id": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"level":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"service":  seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"trace_id":"t1","level":"info","service":"api"}`,
+		`{"trace_id":"t1","level":"error","service":"db"}`,
+		`{"trace_id":"t2","level":"info","service":"api"}`,
+		`{"trace_id":"t3","level":"info","service":"auth"}`,
+		`{"trace_id":"t3","level":"error","service":"auth"}`,
+		`{"trace_id":"t4","level":"info","service":"db"}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string) []string {
+		s.T().Helper()
+		resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(time.Now().Add(-time.Hour)),
+				To:    timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			Size: 10,
+		})
+		var services []string
+		for _, doc := range resp.Docs {
+			obj := struct {
+				Service string `json:"service"`
+			}{}
+			r.NoError(json.Unmarshal(doc.Data, &obj))
+			services = append(services, obj.Service)
+		}
+		return services
+	}
+
+	r.ElementsMatch([]string{"api", "db", "auth", "auth"}, search(`trace_id:in(select trace_id where level:error)`))
+	r.ElementsMatch([]string{"api"}, search(`level:info and trace_id:in(select trace_id where level:error and service:db)`))
+	r.ElementsMatch([]string{"api", "db"}, search(`trace_id:in(select trace_id where service:in(db, auth) | limit 1)`))
+	r.Empty(search(`trace_id:in(select trace_id where level:fatal)`))
+	r.ElementsMatch([]string{"api", "api", "auth", "db"},
+		search(`level:info and not trace_id:in(select trace_id where level:fatal)`))
+	// Subqueries of the inner query are resolved too.
+	r.ElementsMatch([]string{"api", "db", "api", "db"},
+		search(`trace_id:in(select trace_id where service:in(select service where trace_id:t1) and level:info)`))
+}
+
+func (s *IntegrationTestSuite) TestSearchTimeFilter() {
+	env := setup.NewTestingEnv(s.Config)
+	defer env.StopAll()
+
+	now := time.Now()
+	docTime := func(d time.Duration) string {
+		return now.Add(-d).UTC().Format(time.RFC3339Nano)
+	}
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"a","time":"` + docTime(5*time.Minute) + `"}`,
+		`{"service":"b","time":"` + docTime(30*time.Minute) + `"}`,
+		`{"service":"c","time":"` + docTime(2*time.Hour) + `"}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	search := func(query string, from, to time.Time) []string {
+		s.T().Helper()
+		resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+			Query: &seqproxyapi.SearchQuery{
+				Query: query,
+				From:  timestamppb.New(from),
+				To:    timestamppb.New(to),
+			},
+			Size:      10,
+			WithTotal: true,
+		})
+		var services []string
+		for _, doc := range resp.Docs {
+			obj := struct {
+				Service string `json:"service"`
+			}{}
+			r.NoError(json.Unmarshal(doc.Data, &obj))
+			services = append(services, obj.Service)
+		}
+		return services
+	}
+
+	dayAgo, hourLater := now.Add(-24*time.Hour), now.Add(time.Hour)
+	r.ElementsMatch([]string{"a"}, search(`_time:[now-15m, now]`, dayAgo, hourLater))
+	r.ElementsMatch([]string{"a", "b"}, search(`_time:>now-1h and service:*`, dayAgo, hourLater))
+	r.ElementsMatch([]string{"b", "c"}, search(`service:* and _time:<=now-10m`, dayAgo, hourLater))
+	// The range is intersected with the one of the request.
+	r.ElementsMatch([]string{"b"}, search(`_time:>now-1h and service:in(a, b, c)`, dayAgo, now.Add(-10*time.Minute)))
+	r.Empty(search(`_time:>now-1h and _exists_:service`, dayAgo, now.Add(-3*time.Hour)))
+}
+
 func (s *IntegrationTestSuite) TestSearchOneHTTP() {
 	origDocs := []string{
 		`{"service":"a", "xxxx":"yyyy"}`,
@@ -908,7 +1386,7 @@ func (s *IntegrationTestSuite) TestTimeseries() {
 
 		bins := sortedTimeBins(hist)
 		for i := range timeBinsCount {
-			require.Equal(t, float64(nextBin*i+5), hist[bins[i]].Quantile(0.5))
+			require.InEpsilon(t, float64(nextBin*i+5), hist[bins[i]].Quantile(0.5), seq.DefaultQuantileError)
 		}
 	})
 }
@@ -925,11 +1403,11 @@ func (s *IntegrationTestSuite) TestAggStat() {
 	t := s.T()
 
 	cfg := *s.Config
-	cfg.Mapping = map[string]seq.MappingTypes{
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"v":       seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"level":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-	}
+	}}
 
 	type Expected struct {
 		NotExists int64
@@ -1181,6 +1659,11 @@ func (s *IntegrationTestSuite) TestAggStat() {
 				expBucket.Value = 0
 				gotBucket.Value = 0
 			}
+			// Quantiles are estimated within the relative error.
+			if expBucket.Quantiles != nil {
+				r.InEpsilonSlice(expBucket.Quantiles, gotBucket.Quantiles, seq.DefaultQuantileError)
+				expBucket.Quantiles, gotBucket.Quantiles = nil, nil
+			}
 			r.EqualValues(expBucket, gotBucket)
 		}
 	}
@@ -1207,6 +1690,252 @@ func (s *IntegrationTestSuite) TestAggStat() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestAggThenBy() {
+	cfg := *s.Config
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"level":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"code":    seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+		"v":       seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&cfg)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"api","level":"error","code":500,"v":1}`,
+		`{"service":"api","level":"error","code":500,"v":3}`,
+		`{"service":"api","level":"error","code":502,"v":5}`,
+		`{"service":"api","level":"info","code":200}`,
+		`{"service":"db","level":"error","code":500,"v":10}`,
+		`{"service":"db","code":500,"v":7}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	aggregate := func(q search.AggQuery) seq.AggregationResult {
+		qpr, _, _, err := env.Search(`service:*`, math.MaxInt32, setup.WithAggQuery(q))
+		r.NoError(err)
+		return qpr.Aggregate([]seq.AggregateArgs{{Func: q.Func, GroupByFields: 1 + len(q.ThenBy)}})[0]
+	}
+
+	got := aggregate(search.AggQuery{GroupBy: "service", ThenBy: []string{"level", "code"}, Func: seq.AggFuncCount})
+	// Document without level is not grouped.
+	r.Equal(int64(1), got.NotExists)
+	r.Equal([]seq.AggregationBucket{
+		{Name: "api, error, 500", Value: 2, Keys: []string{"api", "error", "500"}},
+		{Name: "api, error, 502", Value: 1, Keys: []string{"api", "error", "502"}},
+		{Name: "api, info, 200", Value: 1, Keys: []string{"api", "info", "200"}},
+		{Name: "db, error, 500", Value: 1, Keys: []string{"db", "error", "500"}},
+	}, got.Buckets)
+
+	got = aggregate(search.AggQuery{Field: "v", GroupBy: "service", ThenBy: []string{"level"}, Func: seq.AggFuncSum})
+	r.Len(got.Buckets, 3)
+	r.Equal(seq.AggregationBucket{Name: "db, error", Value: 10, Keys: []string{"db", "error"}}, got.Buckets[0])
+	r.Equal(seq.AggregationBucket{Name: "api, error", Value: 9, Keys: []string{"api", "error"}}, got.Buckets[1])
+	// The group has no values of the field.
+	r.Equal("api, info", got.Buckets[2].Name)
+	r.Equal(int64(1), got.Buckets[2].NotExists)
+
+	got = aggregate(search.AggQuery{GroupBy: "level", ThenBy: []string{"service"}, Func: seq.AggFuncUnique})
+	r.Len(got.Buckets, 3)
+}
+
+func (s *IntegrationTestSuite) TestAggCardinality() {
+	cfg := *s.Config
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"user":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&cfg)
+	defer env.StopAll()
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	docs := make([]string, 0, 3000)
+	for i := range 3000 {
+		// Users of the first minute are seen again in the next one.
+		ts := start.Add(time.Second)
+		if i >= 1000 {
+			ts = start.Add(time.Minute + time.Second)
+		}
+		docs = append(docs, fmt.Sprintf(`{"service":"api","user":"u%d","ts":%q}`, i%2000, ts.Format(time.RFC3339Nano)))
+	}
+	for i := range 20 {
+		docs = append(docs, fmt.Sprintf(`{"service":"db","user":"u%d","ts":%q}`, i%10, start.Format(time.RFC3339Nano)))
+	}
+	docs = append(docs, fmt.Sprintf(`{"service":"db","ts":%q}`, start.Format(time.RFC3339Nano)))
+	// Documents are split between the fractions.
+	for part := range slices.Chunk(docs, 700) {
+		setup.Bulk(s.T(), env.IngestorBulkAddr(), part)
+		env.WaitIdle()
+	}
+
+	r := require.New(s.T())
+	aggregate := func(q search.AggQuery) seq.AggregationResult {
+		qpr, _, _, err := env.Search(`service:*`, math.MaxInt32, setup.NoFetch(), setup.WithAggQuery(q))
+		r.NoError(err)
+		return qpr.Aggregate([]seq.AggregateArgs{{Func: q.Func, SkipWithoutTimestamp: q.Interval > 0}})[0]
+	}
+
+	got := aggregate(search.AggQuery{Field: "user", GroupBy: "service", Func: seq.AggFuncCardinality})
+	r.Len(got.Buckets, 2)
+	r.Equal("api", got.Buckets[0].Name)
+	r.InEpsilon(2000, got.Buckets[0].Value, 0.03)
+	// Small cardinalities are exact.
+	r.Equal(seq.AggregationBucket{Name: "db", Value: 10, NotExists: 1}, got.Buckets[1])
+
+	got = aggregate(search.AggQuery{Field: "user", Func: seq.AggFuncCardinality})
+	r.Len(got.Buckets, 1)
+	r.InEpsilon(2000, got.Buckets[0].Value, 0.03)
+
+	got = aggregate(search.AggQuery{
+		Field:    "user",
+		GroupBy:  "service",
+		Func:     seq.AggFuncCardinality,
+		Interval: seq.MID(time.Minute.Milliseconds()),
+	})
+	r.Len(got.Buckets, 3)
+	byTime := make(map[string]float64)
+	for _, b := range got.Buckets {
+		byTime[b.Name+" "+b.MID.Time().Sub(start).String()] = b.Value
+	}
+	r.Equal(float64(10), byTime["db 0s"])
+	r.Equal(float64(1000), byTime["api 0s"])
+	r.InEpsilon(2000, byTime["api 1m0s"], 0.03)
+}
+
+func (s *IntegrationTestSuite) TestAggBuckets() {
+	cfg := *s.Config
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"latency": seq.NewSingleType(seq.TokenizerTypeLong, "", 0),
+		"size":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&cfg)
+	defer env.StopAll()
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		`{"service":"api","latency":10,"size":1}`,
+		`{"service":"api","latency":50,"size":3}`,
+		`{"service":"api","latency":120,"size":10}`,
+		`{"service":"db","latency":300,"size":20}`,
+		`{"service":"db","latency":450}`,
+		`{"service":"api","latency":700,"size":30}`,
+		`{"service":"db","latency":2500,"size":100}`,
+		`{"service":"db"}`,
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	aggregate := func(q search.AggQuery) seq.AggregationResult {
+		qpr, _, _, err := env.Search(`service:*`, math.MaxInt32, setup.NoFetch(), setup.WithAggQuery(q))
+		r.NoError(err)
+		return qpr.Aggregate([]seq.AggregateArgs{{
+			Func:          q.Func,
+			GroupByFields: 1 + len(q.ThenBy),
+			Buckets:       q.Buckets,
+		}})[0]
+	}
+
+	got := aggregate(search.AggQuery{
+		GroupBy: "latency",
+		Func:    seq.AggFuncCount,
+		Buckets: seq.NumericBuckets{Ranges: []float64{100, 500}},
+	})
+	r.Equal([]seq.AggregationBucket{
+		{Name: "[-inf,100)", Value: 2},
+		{Name: "[100,500)", Value: 3},
+		{Name: "[500,+inf)", Value: 2},
+	}, got.Buckets)
+
+	got = aggregate(search.AggQuery{
+		Field:   "size",
+		GroupBy: "latency",
+		Func:    seq.AggFuncAvg,
+		Buckets: seq.NumericBuckets{BinLogBase: 10},
+	})
+	r.Equal([]seq.AggregationBucket{
+		{Name: "10", Value: 2},
+		{Name: "100", Value: 20, NotExists: 1},
+		{Name: "1000", Value: 100},
+	}, got.Buckets)
+
+	got = aggregate(search.AggQuery{
+		GroupBy: "latency",
+		ThenBy:  []string{"service"},
+		Func:    seq.AggFuncCount,
+		Buckets: seq.NumericBuckets{BinWidth: 1000},
+	})
+	r.Equal([]seq.AggregationBucket{
+		{Name: "0, api", Value: 4, Keys: []string{"0", "api"}},
+		{Name: "0, db", Value: 2, Keys: []string{"0", "db"}},
+		{Name: "2000, db", Value: 1, Keys: []string{"2000", "db"}},
+	}, got.Buckets)
+}
+
+func (s *IntegrationTestSuite) TestAggFilters() {
+	cfg := *s.Config
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"level":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&cfg)
+	defer env.StopAll()
+
+	minute := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	doc := func(offset time.Duration, fields string) string {
+		return fmt.Sprintf(`{"ts":%q,%s}`, minute.Add(offset).Format(time.RFC3339), fields)
+	}
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), []string{
+		doc(10*time.Second, `"service":"api","level":"error","message":"request timeout"`),
+		doc(20*time.Second, `"service":"api","level":"error","message":"bad request"`),
+		doc(30*time.Second, `"service":"api","level":"info","message":"upstream timeout"`),
+		doc(70*time.Second, `"service":"api","level":"error","message":"connection timeout"`),
+		doc(80*time.Second, `"service":"api","level":"info","message":"ok"`),
+		doc(90*time.Second, `"service":"db","level":"error","message":"query timeout"`),
+	})
+	env.WaitIdle()
+
+	r := require.New(s.T())
+	names := []string{"timeouts", "errors", "fatal"}
+	filters := []search.AggFilter{
+		{Name: "timeouts", Query: "message:timeout"},
+		{Name: "errors", Query: "level:error"},
+		{Name: "fatal", Query: "level:fatal"},
+	}
+
+	qpr, _, _, err := env.Search(`service:api`, math.MaxInt32, setup.NoFetch(), setup.WithAggQuery(search.AggQuery{
+		Func:    seq.AggFuncCount,
+		Filters: filters,
+	}))
+	r.NoError(err)
+	got := qpr.Aggregate([]seq.AggregateArgs{{Func: seq.AggFuncCount, Filters: names}})[0]
+	r.Equal([]seq.AggregationBucket{
+		{Name: "timeouts", Value: 3},
+		{Name: "errors", Value: 3},
+		{Name: "fatal", Value: 0},
+	}, got.Buckets)
+
+	qpr, _, _, err = env.Search(`service:api`, math.MaxInt32, setup.NoFetch(), setup.WithAggQuery(search.AggQuery{
+		Func:     seq.AggFuncCount,
+		Filters:  filters,
+		Interval: 60 * 1000,
+	}))
+	r.NoError(err)
+	got = qpr.Aggregate([]seq.AggregateArgs{{Func: seq.AggFuncCount, Filters: names}})[0]
+	mid := seq.TimeToMID(minute)
+	r.Equal([]seq.AggregationBucket{
+		{Name: "timeouts", Value: 2, MID: mid},
+		{Name: "errors", Value: 2, MID: mid},
+		{Name: "timeouts", Value: 1, MID: mid + 60*1000},
+		{Name: "errors", Value: 1, MID: mid + 60*1000},
+	}, got.Buckets)
+}
+
 func (s *IntegrationTestSuite) TestAggNoTotal() {
 	env := setup.NewTestingEnv(s.Config)
 	defer env.StopAll()
@@ -1873,10 +2602,10 @@ func (s *IntegrationTestSuite) TestAggregateFieldsWithMultipleTypes() {
 // time field is replaced with time.Now()
 func (s *IntegrationTestSuite) TestTimeField() {
 	config := *s.Config
-	config.Mapping = map[string]seq.MappingTypes{
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"event":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"message": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-	}
+	}}
 
 	env := setup.NewTestingEnv(&config)
 	defer env.StopAll()
@@ -1908,18 +2637,163 @@ func (s *IntegrationTestSuite) TestTimeField() {
 	r.Equal(len(docs), len(resp.Docs))
 }
 
+// assertSeqQLSearch checks that seq-ql query finds docs with expected indexes in any order.
+func (s *IntegrationTestSuite) assertSeqQLSearch(env *setup.TestingEnv, query string, docs []string, expected []int) {
+	s.T().Helper()
+
+	now := time.Now()
+	resp := setup.SearchHTTP(s.T(), env.IngestorSearchAddr(), &seqproxyapi.SearchRequest{
+		Query: &seqproxyapi.SearchQuery{
+			Query: query,
+			From:  timestamppb.New(now.Add(-time.Hour)),
+			To:    timestamppb.New(now.Add(time.Hour)),
+		},
+		Size: int64(len(docs)),
+	})
+
+	actual := []string{}
+	for _, doc := range resp.Docs {
+		actual = append(actual, string(doc.Data))
+	}
+	expectedDocs := []string{}
+	for _, i := range expected {
+		expectedDocs = append(expectedDocs, docs[i])
+	}
+	s.Require().ElementsMatch(expectedDocs, actual, query)
+}
+
+func (s *IntegrationTestSuite) TestSearchPhrase() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"message": seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"service":"db","message":"connection reset by peer"}`,
+		`{"service":"api","message":"peer reset connection by timeout"}`,
+		`{"service":"api","message":"read: Connection Reset by peer"}`,
+		`{"service":"db","message":"reset reset by peer twice"}`,
+	}
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), docs)
+	env.WaitIdle()
+
+	test := func(query string, expected []int) {
+		s.T().Helper()
+		s.assertSeqQLSearch(env, query, docs, expected)
+	}
+
+	test(`message:"connection reset by peer"`, []int{0, 1, 2})
+	test(`message:phrase("connection reset by peer")`, []int{0, 2})
+	test(`message:phrase("reset by peer")`, []int{0, 2, 3})
+	test(`message:phrase("reset reset by")`, []int{3})
+	test(`message:phrase("by peer") and service:api`, []int{2})
+	test(`not message:phrase("reset by")`, []int{1})
+
+	env.SealAll()
+	test(`message:phrase("Connection Reset")`, []int{0, 2})
+	test(`not message:phrase("by peer")`, []int{1})
+}
+
+func (s *IntegrationTestSuite) TestSearchRegexp() {
+	config := *s.Config
+	config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"trace_id": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+		"message":  seq.NewSingleType(seq.TokenizerTypeText, "", 0),
+	}}
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"trace_id":"ab1-123","message":"connection refused"}`,
+		`{"trace_id":"AB42-007","message":"socket closed"}`,
+		`{"trace_id":"ab-123","message":"connected"}`,
+		`{"trace_id":"xab1-123","message":"disconnected by peer"}`,
+	}
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), docs)
+	env.WaitIdle()
+
+	test := func(query string, expected []int) {
+		s.T().Helper()
+		s.assertSeqQLSearch(env, query, docs, expected)
+	}
+
+	test(`trace_id:re("ab[0-9]+-\\d{3}")`, []int{0, 1})
+	test(`message:re("conn.*")`, []int{0, 2})
+	test(`message:re(".*connect.*") and not trace_id:re("ab.*")`, []int{3})
+
+	env.SealAll()
+	test("trace_id:re(`.*ab[0-9]+-\\d+`)", []int{0, 1, 3})
+	test(`message:re("(socket|peer)")`, []int{1, 3})
+}
+
+func (s *IntegrationTestSuite) TestSearchAnalyzer() {
+	mapping, err := seq.ReadMapping([]byte(`
+analyzers:
+  - name: russian
+    ascii_folding: true
+    stopwords: [_russian_]
+    stemmer: russian
+mapping-list:
+  - name: message
+    type: text
+    analyzer: russian
+  - name: service
+    type: keyword
+`))
+	s.Require().NoError(err)
+
+	config := *s.Config
+	config.Mapping = mapping
+
+	env := setup.NewTestingEnv(&config)
+	defer env.StopAll()
+
+	docs := []string{
+		`{"service":"api","message":"Ошибка при подключении к базе"}`,
+		`{"service":"api","message":"ошибки подключения не найдены"}`,
+		`{"service":"café","message":"Соединение с базой установлено"}`,
+		`{"service":"db","message":"Ошибкой считается пустой ответ"}`,
+	}
+
+	setup.Bulk(s.T(), env.IngestorBulkAddr(), docs)
+	env.WaitIdle()
+
+	test := func(query string, expected []int) {
+		s.T().Helper()
+		s.assertSeqQLSearch(env, query, docs, expected)
+	}
+
+	test(`message:ошибки`, []int{0, 1, 3})
+	test(`message:"подключение к базам"`, []int{0})
+	// Stop words are skipped in phrases.
+	test(`message:phrase("ошибка подключений")`, []int{0, 1})
+	test(`message:in(базу, ответы)`, []int{0, 2, 3})
+
+	env.SealAll()
+	test(`message:ОШИБКАМИ`, []int{0, 1, 3})
+	test(`message:phrase("база установлена")`, []int{2})
+	test(`message:соедин*`, []int{2})
+}
+
 func (s *IntegrationTestSuite) TestAsyncSearch() {
 	t := s.T()
 	r := require.New(t)
 
 	cfg := *s.Config
-	cfg.Mapping = map[string]seq.MappingTypes{
+	cfg.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
 		"ip":     seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"method": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"uri":    seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"status": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
 		"size":   seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
-	}
+	}}
 	env := setup.NewTestingEnv(&cfg)
 	defer env.StopAll()
 
@@ -1994,6 +2868,23 @@ func (s *IntegrationTestSuite) TestAsyncSearch() {
 	r.Equalf(fracmanager.AsyncSearchStatusDone, fresp.Status, "unexpected status code=%d with error=%q", fresp.Status, fresp.QPR.Errors)
 	r.Equal([]seq.ErrorSource(nil), fresp.QPR.Errors)
 	r.True(fresp.ExpiresAt.After(time.Now().UTC()))
+	// Quantiles are estimated within the relative error.
+	expQuantiles := []seq.AggregationBucket{
+		{Name: "delete", Value: 3015, Quantiles: []float64{3015, 3015, 3015}},
+		{Name: "get", Value: 4734, Quantiles: []float64{4734, 4734, 4002}},
+		{Name: "post", Value: 3892, Quantiles: []float64{3892, 3892, 3892}},
+		{Name: "put", Value: 5116, Quantiles: []float64{5116, 5116, 4334}},
+	}
+	r.Len(fresp.AggResult, 2)
+	gotQuantiles := fresp.AggResult[1].Buckets
+	r.Len(gotQuantiles, len(expQuantiles))
+	for i, exp := range expQuantiles {
+		got := gotQuantiles[i]
+		r.Equal(exp.Name, got.Name)
+		r.InEpsilon(exp.Value, got.Value, seq.DefaultQuantileError)
+		r.InEpsilonSlice(exp.Quantiles, got.Quantiles, seq.DefaultQuantileError)
+	}
+
 	r.Equal([]seq.AggregationResult{
 		{
 			Buckets: []seq.AggregationBucket{
@@ -2009,15 +2900,7 @@ func (s *IntegrationTestSuite) TestAsyncSearch() {
 				{Name: "132.240.243.74", Value: 1598},
 			},
 		},
-		{
-			Buckets: []seq.AggregationBucket{
-				{Name: "delete", Value: 3015, Quantiles: []float64{3015, 3015, 3015}},
-				{Name: "get", Value: 4734, Quantiles: []float64{4734, 4734, 4002}},
-				{Name: "post", Value: 3892, Quantiles: []float64{3892, 3892, 3892}},
-				{Name: "put", Value: 5116, Quantiles: []float64{5116, 5116, 4334}},
-			},
-		},
-	}, fresp.AggResult)
+	}, fresp.AggResult[:1])
 	r.Equal(startReq, fresp.Request)
 
 	r.True(len(fresp.QPR.Histogram) != 0)
@@ -474,7 +474,7 @@ func (s *SingleTestSuite) TestWildcardSymbols() {
 }
 
 func (s *SingleTestSuite) TestIndexingAllFields() {
-	defer func(m seq.Mapping, enabled bool) {
+	defer func(m *seq.Mapping, enabled bool) {
 		s.Config.Mapping = m
 		s.Config.IndexAllFields = enabled
 	}(s.Config.Mapping, s.Config.IndexAllFields)
@@ -514,6 +514,38 @@ func (s *SingleTestSuite) TestIndexingAllFields() {
 	})
 }
 
+func (s *SingleTestSuite) TestSearchNgram() {
+	defer func(m *seq.Mapping) {
+		s.Config.Mapping = m
+	}(s.Config.Mapping)
+
+	s.Config.Mapping = &seq.Mapping{Fields: map[string]seq.MappingTypes{
+		"message": seq.NewSingleType(seq.TokenizerTypeNgram, "", 0),
+		"service": seq.NewSingleType(seq.TokenizerTypeKeyword, "", 0),
+	}}
+	s.Restart()
+
+	now := time.Now()
+	docs := []setup.ExampleDoc{
+		{Service: "db", Message: "connection timeout to db", Timestamp: now.Add(time.Millisecond * 10)},
+		{Service: "api", Message: "read timed out", Timestamp: now.Add(time.Millisecond * 20)},
+		{Service: "api", Message: "TIMEOUT exceeded", Timestamp: now.Add(time.Millisecond * 30)},
+		// Contains all trigrams of "timeout", but not the word itself.
+		{Service: "db", Message: "time meout", Timestamp: now.Add(time.Millisecond * 40)},
+	}
+	docStrs := setup.DocsToStrings(docs)
+	s.Bulk(docStrs)
+
+	s.RunFracEnvs(suites.AllFracEnvs, true, func() {
+		s.AssertSearch(`message:*timeout*`, docStrs, []int{2, 0})
+		s.AssertSearch(`message:*out*`, docStrs, []int{3, 2, 1, 0})
+		s.AssertSearch(`message:*ed*`, docStrs, []int{2, 1})
+		s.AssertSearch(`message:"read timed out"`, docStrs, []int{1})
+		s.AssertSearch(`message:*timeout* AND service:db`, docStrs, []int{0})
+		s.AssertSearch(`NOT message:*timeout*`, docStrs, []int{3, 1})
+	})
+}
+
 func (s *SingleTestSuite) TestSealedMultiFetch() {
 	docs := make([]setup.ExampleDoc, 0, consts.IDsPerBlock*2)
 	nextTs := getAutoTimeGenerator(time.Now(), time.Millisecond*10)
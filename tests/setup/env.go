@@ -49,7 +49,7 @@ type TestingEnvConfig struct {
 	QueryRateLimit    *float64
 	FracManagerConfig *fracmanager.Config
 
-	Mapping        seq.Mapping
+	Mapping        *seq.Mapping
 	IndexAllFields bool
 
 	S3Cli *seqs3.Client
@@ -176,7 +176,7 @@ func NewTestingEnv(cfg *TestingEnvConfig) *TestingEnv {
 		logger.Fatal("empty data dir")
 	}
 
-	if len(cfg.Mapping) == 0 && !cfg.IndexAllFields {
+	if cfg.Mapping == nil && !cfg.IndexAllFields {
 		cfg.Mapping = seq.TestMapping
 	}
 
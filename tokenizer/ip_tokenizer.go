@@ -0,0 +1,32 @@
+package tokenizer
+
+import (
+	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/metric"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/util"
+)
+
+// IPTokenizer converts IPv4 and IPv6 addresses to fixed-width binary tokens (see seq.AppendIP),
+// so different spellings of the same address produce the same token
+// and address ranges can be evaluated with binary search.
+type IPTokenizer struct{}
+
+func NewIPTokenizer() *IPTokenizer {
+	return &IPTokenizer{}
+}
+
+func (t *IPTokenizer) Tokenize(tokens []frac.MetaToken, name, value []byte, _ int) []frac.MetaToken {
+	token, ok := seq.AppendParsedIP(make([]byte, 0, seq.IPTokenSize), util.ByteToStringUnsafe(value))
+	if !ok {
+		// Value is not an ip address, so we can't index it.
+		metric.SkippedIndexesIP.Inc()
+		return tokens
+	}
+
+	tokens = append(tokens, frac.MetaToken{
+		Key:   name,
+		Value: token,
+	})
+	return tokens
+}
@@ -0,0 +1,30 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestIPTokenizer(t *testing.T) {
+	tokenizer := NewIPTokenizer()
+
+	expected, ok := seq.AppendParsedIP(nil, "2001:db8::1")
+	require.True(t, ok)
+
+	for _, value := range []string{"2001:db8::1", "2001:0DB8:0:0:0:0:0:1"} {
+		tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("client_ip"), []byte(value), 0)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, []byte("client_ip"), tokens[0].Key)
+		assert.Equal(t, expected, tokens[0].Value)
+	}
+
+	for _, value := range []string{"", "localhost", "10.0.0.256"} {
+		tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("client_ip"), []byte(value), 0)
+		assert.Empty(t, tokens, value)
+	}
+}
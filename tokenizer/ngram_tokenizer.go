@@ -0,0 +1,75 @@
+package tokenizer
+
+import (
+	"unicode/utf8"
+
+	"github.com/ozontech/seq-db/consts"
+	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/metric"
+	"github.com/ozontech/seq-db/seq"
+)
+
+// NgramTokenizer indexes the whole value like KeywordTokenizer does
+// and all its n-grams in the separate field (see seq.NgramField).
+// N-grams make it possible to find values by substring without scanning all tokens of the field.
+type NgramTokenizer struct {
+	defaultMaxTokenSize int
+	caseSensitive       bool
+	partialIndexing     bool
+}
+
+func NewNgramTokenizer(maxTokenSize int, caseSensitive, partialIndexing bool) *NgramTokenizer {
+	return &NgramTokenizer{
+		defaultMaxTokenSize: maxTokenSize,
+		caseSensitive:       caseSensitive,
+		partialIndexing:     partialIndexing,
+	}
+}
+
+func (t *NgramTokenizer) Tokenize(tokens []frac.MetaToken, name, value []byte, maxTokenSize int) []frac.MetaToken {
+	return t.TokenizeGrams(tokens, name, value, maxTokenSize, consts.DefaultMinGram, consts.DefaultMaxGram)
+}
+
+// TokenizeGrams works like Tokenize, but uses the given n-gram lengths. N-gram length is measured in runes.
+func (t *NgramTokenizer) TokenizeGrams(tokens []frac.MetaToken, name, value []byte, maxTokenSize, minGram, maxGram int) []frac.MetaToken {
+	if maxTokenSize == 0 {
+		maxTokenSize = t.defaultMaxTokenSize
+	}
+
+	if len(value) > maxTokenSize && !t.partialIndexing {
+		metric.SkippedIndexesNgram.Inc()
+		metric.SkippedIndexesBytesNgram.Add(float64(len(value)))
+		return tokens
+	}
+
+	maxLength := min(len(value), maxTokenSize)
+	metric.SkippedIndexesBytesNgram.Add(float64(len(value[maxLength:])))
+	value = toLowerIfCaseInsensitive(t.caseSensitive, value[:maxLength])
+
+	tokens = append(tokens, frac.MetaToken{
+		Key:   name,
+		Value: value,
+	})
+
+	gramsKey := make([]byte, 0, len(name)+len(seq.NgramFieldSuffix))
+	gramsKey = append(append(gramsKey, name...), seq.NgramFieldSuffix...)
+
+	// Offsets of the last maxGram+1 runes, so n-grams ending at the current rune can be sliced from the value.
+	starts := make([]int, 0, maxGram+1)
+	for i := 0; i < len(value); {
+		_, size := utf8.DecodeRune(value[i:])
+		if len(starts) == maxGram {
+			starts = append(starts[:0], starts[1:]...)
+		}
+		starts = append(starts, i)
+		i += size
+
+		for n := minGram; n <= len(starts); n++ {
+			tokens = append(tokens, frac.MetaToken{
+				Key:   gramsKey,
+				Value: value[starts[len(starts)-n]:i],
+			})
+		}
+	}
+	return tokens
+}
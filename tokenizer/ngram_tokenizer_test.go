@@ -0,0 +1,59 @@
+package tokenizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozontech/seq-db/frac"
+)
+
+func TestNgramTokenizer(t *testing.T) {
+	tokenizer := NewNgramTokenizer(100, false, false)
+
+	tokens := tokenizer.TokenizeGrams([]frac.MetaToken{}, []byte("message"), []byte("TimeOut"), 0, 3, 4)
+	assert.Equal(t, []frac.MetaToken{
+		newFracToken("message", "timeout"),
+		newFracToken("message#ngram", "tim"),
+		newFracToken("message#ngram", "ime"),
+		newFracToken("message#ngram", "time"),
+		newFracToken("message#ngram", "meo"),
+		newFracToken("message#ngram", "imeo"),
+		newFracToken("message#ngram", "eou"),
+		newFracToken("message#ngram", "meou"),
+		newFracToken("message#ngram", "out"),
+		newFracToken("message#ngram", "eout"),
+	}, tokens)
+}
+
+func TestNgramTokenizerUTF8(t *testing.T) {
+	tokenizer := NewNgramTokenizer(100, true, false)
+
+	tokens := tokenizer.TokenizeGrams([]frac.MetaToken{}, []byte("message"), []byte("Тайм"), 0, 3, 3)
+	assert.Equal(t, []frac.MetaToken{
+		newFracToken("message", "Тайм"),
+		newFracToken("message#ngram", "Тай"),
+		newFracToken("message#ngram", "айм"),
+	}, tokens)
+}
+
+func TestNgramTokenizerShortValue(t *testing.T) {
+	tokenizer := NewNgramTokenizer(100, true, false)
+
+	tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("message"), []byte("ok"), 0)
+	assert.Equal(t, []frac.MetaToken{newFracToken("message", "ok")}, tokens)
+}
+
+func TestNgramTokenizerMaxLength(t *testing.T) {
+	tokenizer := NewNgramTokenizer(100, true, false)
+	tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("message"), []byte("hello world"), 10)
+	assert.Equal(t, []frac.MetaToken{}, tokens)
+
+	tokenizer = NewNgramTokenizer(100, true, true)
+	tokens = tokenizer.TokenizeGrams([]frac.MetaToken{}, []byte("message"), []byte("hello world"), 4, 3, 3)
+	assert.Equal(t, []frac.MetaToken{
+		newFracToken("message", "hell"),
+		newFracToken("message#ngram", "hel"),
+		newFracToken("message#ngram", "ell"),
+	}, tokens)
+}
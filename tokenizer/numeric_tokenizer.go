@@ -0,0 +1,53 @@
+package tokenizer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/metric"
+	"github.com/ozontech/seq-db/seq"
+	"github.com/ozontech/seq-db/util"
+)
+
+// NumericTokenizer converts numbers and dates to binary tokens (see seq.AppendLong, seq.AppendDouble and seq.AppendDate),
+// which keep numeric order after sealing, so range queries can be evaluated with binary search.
+type NumericTokenizer struct {
+	tokenizerType seq.TokenizerType
+	skipped       prometheus.Counter
+}
+
+func NewLongTokenizer() *NumericTokenizer {
+	return &NumericTokenizer{
+		tokenizerType: seq.TokenizerTypeLong,
+		skipped:       metric.SkippedIndexesLong,
+	}
+}
+
+func NewDoubleTokenizer() *NumericTokenizer {
+	return &NumericTokenizer{
+		tokenizerType: seq.TokenizerTypeDouble,
+		skipped:       metric.SkippedIndexesDouble,
+	}
+}
+
+func NewDateTokenizer() *NumericTokenizer {
+	return &NumericTokenizer{
+		tokenizerType: seq.TokenizerTypeDate,
+		skipped:       metric.SkippedIndexesDate,
+	}
+}
+
+func (t *NumericTokenizer) Tokenize(tokens []frac.MetaToken, name, value []byte, _ int) []frac.MetaToken {
+	token, ok := seq.AppendNumeric(make([]byte, 0, seq.NumericTokenSize), t.tokenizerType, util.ByteToStringUnsafe(value))
+	if !ok {
+		// Value is not a number, so we can't index it.
+		t.skipped.Inc()
+		return tokens
+	}
+
+	tokens = append(tokens, frac.MetaToken{
+		Key:   name,
+		Value: token,
+	})
+	return tokens
+}
@@ -0,0 +1,75 @@
+package tokenizer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ozontech/seq-db/frac"
+	"github.com/ozontech/seq-db/seq"
+)
+
+func TestLongTokenizer(t *testing.T) {
+	tokenizer := NewLongTokenizer()
+
+	tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("bytes"), []byte("-42"), 0)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, []byte("bytes"), tokens[0].Key)
+	assert.Equal(t, seq.AppendLong(nil, -42), tokens[0].Value)
+
+	tokens = tokenizer.Tokenize([]frac.MetaToken{}, []byte("bytes"), []byte("1e3"), 0)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, seq.AppendLong(nil, 1000), tokens[0].Value)
+
+	for _, value := range []string{"", "abc", "1.5", "100500100500100500100500"} {
+		tokens = tokenizer.Tokenize([]frac.MetaToken{}, []byte("bytes"), []byte(value), 0)
+		assert.Empty(t, tokens, value)
+	}
+}
+
+func TestDoubleTokenizer(t *testing.T) {
+	tokenizer := NewDoubleTokenizer()
+
+	tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("duration"), []byte("-0.25"), 0)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, seq.AppendDouble(nil, -0.25), tokens[0].Value)
+
+	for _, value := range []string{"", "abc", "NaN", "+Inf"} {
+		tokens = tokenizer.Tokenize([]frac.MetaToken{}, []byte("duration"), []byte(value), 0)
+		assert.Empty(t, tokens, value)
+	}
+}
+
+func TestNumericTokensOrder(t *testing.T) {
+	tokenizer := NewDoubleTokenizer()
+
+	values := []string{"-1e300", "-100.5", "-1", "-0.001", "0", "0.001", "1", "2", "10", "100.5", "1e300"}
+	var prev []byte
+	for _, value := range values {
+		tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("duration"), []byte(value), 0)
+		require.Len(t, tokens, 1)
+		if prev != nil {
+			assert.Equal(t, -1, bytes.Compare(prev, tokens[0].Value), value)
+		}
+		prev = tokens[0].Value
+	}
+}
+
+func TestDateTokenizer(t *testing.T) {
+	tokenizer := NewDateTokenizer()
+
+	expected := seq.AppendDate(nil, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	for _, value := range []string{"2024-01-02T03:04:05Z", "2024-01-02T06:04:05+03:00", "2024-01-02 03:04:05"} {
+		tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("expires_at"), []byte(value), 0)
+		require.Len(t, tokens, 1, value)
+		assert.Equal(t, expected, tokens[0].Value, value)
+	}
+
+	for _, value := range []string{"", "now", "yesterday", "1704164645"} {
+		tokens := tokenizer.Tokenize([]frac.MetaToken{}, []byte("expires_at"), []byte(value), 0)
+		assert.Empty(t, tokens, value)
+	}
+}